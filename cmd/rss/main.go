@@ -2,11 +2,10 @@ package main
 
 import (
 	"context"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gorilla/feeds"
@@ -14,39 +13,34 @@ import (
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/migrate"
 	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/internal/config"
+	"github.com/wintbiit/rmtv/internal/lark"
 
 	_ "github.com/lib/pq"
 )
 
 func main() {
 	godotenv.Load()
-	dbUrl, ok := os.LookupEnv("DB_URL")
-	if !ok {
-		panic("DB_URL is required")
-	}
-
-	addr := os.Getenv("ADDR")
-	if addr == "" {
-		addr = ":8080"
-	}
-
-	maxFeedItemCount := os.Getenv("MAX_FEED_ITEM_COUNT")
-	if maxFeedItemCount == "" {
-		maxFeedItemCount = "10"
-	}
-	maxCount, err := strconv.Atoi(maxFeedItemCount)
+	cfg, err := config.Load(config.Path())
 	if err != nil {
 		panic(err)
 	}
+	if cfg.DB == "" {
+		panic("DB_URL is required")
+	}
 
-	db, err := ent.Open("postgres", dbUrl)
+	addr := cfg.RSS.Addr
+	maxCount := cfg.RSS.MaxItems
+
+	db, err := ent.Open("postgres", cfg.DB)
 	if err != nil {
 		panic(err)
 	}
 	defer db.Close()
 
-	if err := db.Schema.Create(context.Background()); err != nil {
+	if err := db.Schema.Create(context.Background(), migrate.WithDropIndex(true)); err != nil {
 		panic(err)
 	}
 
@@ -84,6 +78,7 @@ func main() {
 					Name: item.Author,
 				},
 				Description: item.Description,
+				Content:     item.Content,
 				Id:          item.ID,
 				Updated:     item.UpdatedAt,
 				Created:     item.CreatedAt,
@@ -96,6 +91,33 @@ func main() {
 		return nil
 	}
 
+	// The Lark app answers bot commands and welcomes new chats through its
+	// event callback.
+	if larkApp := cfg.Consumers.Lark; larkApp != nil {
+		client := lark.NewClient(larkApp.AppId, larkApp.AppSecret)
+		client.SetDb(db)
+		if t := cfg.Consumers.CardTemplate; t != nil {
+			client.SetTemplate(lark.NewTemplate(t.Id, t.IdNoImage, t.ImageFallback))
+		}
+		client.SetDigestCount(lo.FromPtrOr(larkApp.DigestCount, 5))
+		if larkApp.ImageCacheTTL > 0 {
+			client.SetImageCacheTTL(larkApp.ImageCacheTTL)
+		}
+		routes, destinations, err := cfg.RouteDestinations()
+		if err != nil {
+			panic(err)
+		}
+		client.SetRoutes(routes, destinations)
+		// Requests could not be told from forged ones without either.
+		if larkApp.VerificationToken == "" && larkApp.EncryptKey == "" {
+			logrus.Warn("Lark event callback disabled, set consumers.lark.verification_token or encrypt_key to enable it")
+		} else {
+			events := lark.NewEventHandler(larkApp.VerificationToken, larkApp.EncryptKey)
+			client.HandleEvents(events)
+			app.Post("/lark/event", adaptor.HTTPHandler(events))
+		}
+	}
+
 	app.Get("/rss", getFeeds)
 	app.Get("/rss/:source", getFeeds)
 
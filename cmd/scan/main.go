@@ -2,74 +2,236 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"net/http"
 	"os"
-	"strconv"
+	"os/signal"
 	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
-	"github.com/wintbiit/rmtv/internal/bilibili"
+	"github.com/wintbiit/rmtv/internal/config"
 	"github.com/wintbiit/rmtv/internal/job"
 	"github.com/wintbiit/rmtv/internal/lark"
-	"github.com/wintbiit/rmtv/internal/qflow"
-	"github.com/wintbiit/rmtv/internal/rmbbs"
-)
 
-var modules = map[string]func() job.MessageProvider{
-	bilibili.Module: func() job.MessageProvider {
-		return bilibili.NewClient()
-	},
-	rmbbs.Module: func() job.MessageProvider {
-		return rmbbs.NewClient()
-	},
-	qflow.Module: func() job.MessageProvider {
-		return qflow.NewClient()
-	},
-}
+	_ "github.com/wintbiit/rmtv/internal/bilibili"
+	_ "github.com/wintbiit/rmtv/internal/feed"
+	_ "github.com/wintbiit/rmtv/internal/github"
+	_ "github.com/wintbiit/rmtv/internal/qflow"
+	_ "github.com/wintbiit/rmtv/internal/rmbbs"
+)
 
 func main() {
 	godotenv.Load()
 
-	db, ok := os.LookupEnv("DB_URL")
-	if !ok {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "providers":
+			providers()
+			return
+		case "config":
+			configCommand(os.Args[2:])
+			return
+		}
+	}
+
+	cfg, err := config.Load(config.Path())
+	if err != nil {
+		logrus.Errorf("invalid config:\n%v", err)
+		os.Exit(1)
+	}
+	if cfg.DB == "" {
 		panic("DB_URL is required")
 	}
 
-	enableModules, ok := os.LookupEnv("ENABLE_MODULES")
-	if !ok {
-		enableModules = "bilibili,rmbbs,qflow"
+	j, err := newJob(cfg)
+	if err != nil {
+		logrus.Errorf("invalid provider config:\n%v", err)
+		os.Exit(1)
+	}
+
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "daemon":
+			daemon(j, cfg)
+			return
+		case "backfill":
+			backfill(j, os.Args[2:])
+			return
+		}
+	}
+
+	if err := j.Run(context.Background()); err != nil {
+		logrus.Error(errors.Wrap(err, "failed to run job"))
+		os.Exit(1)
+	}
+}
+
+func newJob(cfg *config.Config) (*job.TvJob, error) {
+	instances, err := cfg.Instances()
+	if err != nil {
+		return nil, err
 	}
-	logrus.Infof("enabled modules: %v", enableModules)
+
+	logrus.Infof("enabled providers: %v", strings.Join(lo.Map(instances, func(item *job.Instance, _ int) string {
+		return item.Name
+	}), ","))
 
 	j := job.NewTvJob(
-		job.WithDb(db),
+		job.WithDb(cfg.DB),
+		job.WithNotifyUpdates(cfg.Scan.NotifyUpdates),
+		job.WithLookback(cfg.Scan.Lookback),
 	)
 
-	for _, module := range strings.Split(enableModules, ",") {
-		if f, ok := modules[module]; ok {
-			j = j.With(job.WithProvider(f()))
+	for _, instance := range instances {
+		p, err := instance.New()
+		if err != nil {
+			return nil, err
 		}
+		j = j.With(job.WithProvider(p))
 	}
 
-	if larkAppId, ok := os.LookupEnv("LARK_APP_ID"); ok {
-		j = j.With(job.WithConsumer(lark.NewClient(larkAppId, os.Getenv("LARK_APP_SECRET"))))
-		logrus.Infof("enabled lark client with app id: %v", larkAppId)
+	for _, p := range cfg.Providers {
+		if p.Interval > 0 {
+			j = j.With(job.WithProviderInterval(p.Name, p.Interval))
+		}
 	}
 
-	if larkWebhooks, ok := os.LookupEnv("LARK_WEBHOOKS"); ok {
-		j = j.With(job.WithConsumer(lark.NewWebhookClient(strings.Split(larkWebhooks, ","))))
-		logrus.Infof("enabled lark webhook client with file: %v", larkWebhooks)
+	// Hosted card templates replace code built cards when configured.
+	var template *lark.Template
+	if t := cfg.Consumers.CardTemplate; t != nil {
+		template = lark.NewTemplate(t.Id, t.IdNoImage, t.ImageFallback)
 	}
 
-	if maxCountPerPush, ok := os.LookupEnv("MAX_COUNT_PER_PUSH"); ok {
-		if count, err := strconv.Atoi(maxCountPerPush); err == nil && count > 0 {
-			j = j.With(job.WithMaxCountPerPush(count))
+	var larkClient *lark.Client
+	if app := cfg.Consumers.Lark; app != nil {
+		larkClient = lark.NewClient(app.AppId, app.AppSecret)
+		larkClient.SetTemplate(template)
+		if app.ImageCacheTTL > 0 {
+			larkClient.SetImageCacheTTL(app.ImageCacheTTL)
 		}
+		j = j.With(job.WithConsumer(larkClient))
+		logrus.Infof("enabled lark client with app id: %v", app.AppId)
 	}
 
-	if err := j.Run(context.Background()); err != nil {
-		logrus.Error(errors.Wrap(err, "failed to run job"))
+	if webhooks := cfg.Consumers.LarkWebhooks; len(webhooks) > 0 {
+		client := lark.NewWebhookClient(lark.WebhookModule, webhooks)
+		client.SetTemplate(template)
+		client.SetUploader(larkClient)
+		j = j.With(job.WithConsumer(client))
+		logrus.Infof("enabled lark webhook client with %d webhooks", len(webhooks))
+	}
+
+	for _, d := range cfg.Consumers.Destinations {
+		if len(d.LarkChats) > 0 {
+			j = j.With(job.WithConsumer(larkClient.Chats(d.Name, d.LarkChats)))
+		} else {
+			client := lark.NewWebhookClient(d.Name, d.LarkWebhooks)
+			client.SetTemplate(template)
+			client.SetUploader(larkClient)
+			j = j.With(job.WithConsumer(client))
+		}
+		logrus.Infof("enabled destination %s", d.Name)
+	}
+
+	routes, _, err := cfg.RouteDestinations()
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routes {
+		j = j.With(job.WithRoute(route))
+	}
+
+	if cfg.Scan.MaxCountPerPush > 0 {
+		j = j.With(job.WithMaxCountPerPush(cfg.Scan.MaxCountPerPush))
+	}
+	if cfg.Scan.MaxAttempts > 0 {
+		j = j.With(job.WithMaxAttempts(cfg.Scan.MaxAttempts))
+	}
+	if cfg.Scan.Interval > 0 {
+		j = j.With(job.WithInterval(cfg.Scan.Interval))
+	}
+	if cfg.Scan.Jitter != nil {
+		j = j.With(job.WithJitter(*cfg.Scan.Jitter))
+	}
+
+	return j, nil
+}
+
+func daemon(j *job.TvJob, cfg *config.Config) {
+	if addr := cfg.Scan.MetricsAddr; addr != "" {
+		// The job publishes its scan counters through expvar on /debug/vars.
+		go func() {
+			logrus.Errorf("metrics server stopped: %v", http.ListenAndServe(addr, nil))
+		}()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := j.Serve(ctx); err != nil {
+		logrus.Error(errors.Wrap(err, "failed to serve job"))
+		os.Exit(1)
+	}
+}
+
+func backfill(j *job.TvJob, args []string) {
+	flags := flag.NewFlagSet("backfill", flag.ExitOnError)
+	source := flags.String("source", "", "provider to backfill")
+	since := flags.String("since", "", "backfill posts published since this date, e.g. 2025-01-01")
+	flags.Parse(args)
+
+	if *source == "" || *since == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	sinceTime, err := time.ParseInLocation(time.DateOnly, *since, time.Local)
+	if err != nil {
+		logrus.Fatalf("invalid since: %v", err)
+	}
+
+	if err := j.Backfill(context.Background(), *source, sinceTime); err != nil {
+		logrus.Error(errors.Wrap(err, "failed to backfill"))
+		os.Exit(1)
+	}
+}
+
+func providers() {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, t := range job.ProviderTypes() {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t.Name, t.Description, strings.Join(t.EnvVars(), ", "))
+	}
+	w.Flush()
+}
+
+// configCommand handles "config check [file]", validating the config file
+// and the config of every provider with env overrides applied.
+func configCommand(args []string) {
+	if len(args) == 0 || args[0] != "check" {
+		fmt.Fprintln(os.Stderr, "usage: rmtv config check [file]")
+		os.Exit(2)
+	}
+
+	path := config.Path()
+	if len(args) > 1 {
+		path = args[1]
+	}
+
+	cfg, err := config.Load(path)
+	if err == nil {
+		err = cfg.Check()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+
+	fmt.Printf("%s: ok\n", lo.Ternary(path == "", "env", path))
 }
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wintbiit/rmtv/ent/delivery"
+	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/postrevision"
+)
+
+// Delivery is the model entity for the Delivery schema.
+type Delivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 帖子ID
+	PostID string `json:"post_id,omitempty"`
+	// 更新记录ID
+	RevisionID *int `json:"revision_id,omitempty"`
+	// 消费者
+	Consumer string `json:"consumer,omitempty"`
+	// 投递目标，如群聊或 Webhook，为空时尚未按目标拆分
+	Target string `json:"target,omitempty"`
+	// 投递状态
+	Status delivery.Status `json:"status,omitempty"`
+	// 尝试次数
+	Attempts int `json:"attempts,omitempty"`
+	// 最近错误
+	LastError string `json:"last_error,omitempty"`
+	// 下次重试时间
+	NextRetryAt time.Time `json:"next_retry_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeliveryQuery when eager-loading is set.
+	Edges        DeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeliveryEdges holds the relations/edges for other nodes in the graph.
+type DeliveryEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// Revision holds the value of the revision edge.
+	Revision *PostRevision `json:"revision,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeliveryEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// RevisionOrErr returns the Revision value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeliveryEdges) RevisionOrErr() (*PostRevision, error) {
+	if e.Revision != nil {
+		return e.Revision, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: postrevision.Label}
+	}
+	return nil, &NotLoadedError{edge: "revision"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Delivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case delivery.FieldID, delivery.FieldRevisionID, delivery.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case delivery.FieldPostID, delivery.FieldConsumer, delivery.FieldTarget, delivery.FieldStatus, delivery.FieldLastError:
+			values[i] = new(sql.NullString)
+		case delivery.FieldNextRetryAt, delivery.FieldCreatedAt, delivery.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Delivery fields.
+func (_m *Delivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case delivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case delivery.FieldPostID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field post_id", values[i])
+			} else if value.Valid {
+				_m.PostID = value.String
+			}
+		case delivery.FieldRevisionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision_id", values[i])
+			} else if value.Valid {
+				_m.RevisionID = new(int)
+				*_m.RevisionID = int(value.Int64)
+			}
+		case delivery.FieldConsumer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field consumer", values[i])
+			} else if value.Valid {
+				_m.Consumer = value.String
+			}
+		case delivery.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				_m.Target = value.String
+			}
+		case delivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = delivery.Status(value.String)
+			}
+		case delivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case delivery.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case delivery.FieldNextRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_retry_at", values[i])
+			} else if value.Valid {
+				_m.NextRetryAt = value.Time
+			}
+		case delivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case delivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Delivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *Delivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the Delivery entity.
+func (_m *Delivery) QueryPost() *PostQuery {
+	return NewDeliveryClient(_m.config).QueryPost(_m)
+}
+
+// QueryRevision queries the "revision" edge of the Delivery entity.
+func (_m *Delivery) QueryRevision() *PostRevisionQuery {
+	return NewDeliveryClient(_m.config).QueryRevision(_m)
+}
+
+// Update returns a builder for updating this Delivery.
+// Note that you need to call Delivery.Unwrap() before calling this method if this Delivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Delivery) Update() *DeliveryUpdateOne {
+	return NewDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Delivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Delivery) Unwrap() *Delivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Delivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Delivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("Delivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("post_id=")
+	builder.WriteString(_m.PostID)
+	builder.WriteString(", ")
+	if v := _m.RevisionID; v != nil {
+		builder.WriteString("revision_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("consumer=")
+	builder.WriteString(_m.Consumer)
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(_m.Target)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("next_retry_at=")
+	builder.WriteString(_m.NextRetryAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Deliveries is a parsable slice of Delivery.
+type Deliveries []*Delivery
@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/delivery"
+	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/postrevision"
+)
+
+// DeliveryCreate is the builder for creating a Delivery entity.
+type DeliveryCreate struct {
+	config
+	mutation *DeliveryMutation
+	hooks    []Hook
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *DeliveryCreate) SetPostID(v string) *DeliveryCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetRevisionID sets the "revision_id" field.
+func (_c *DeliveryCreate) SetRevisionID(v int) *DeliveryCreate {
+	_c.mutation.SetRevisionID(v)
+	return _c
+}
+
+// SetNillableRevisionID sets the "revision_id" field if the given value is not nil.
+func (_c *DeliveryCreate) SetNillableRevisionID(v *int) *DeliveryCreate {
+	if v != nil {
+		_c.SetRevisionID(*v)
+	}
+	return _c
+}
+
+// SetConsumer sets the "consumer" field.
+func (_c *DeliveryCreate) SetConsumer(v string) *DeliveryCreate {
+	_c.mutation.SetConsumer(v)
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *DeliveryCreate) SetTarget(v string) *DeliveryCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_c *DeliveryCreate) SetNillableTarget(v *string) *DeliveryCreate {
+	if v != nil {
+		_c.SetTarget(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DeliveryCreate) SetStatus(v delivery.Status) *DeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DeliveryCreate) SetNillableStatus(v *delivery.Status) *DeliveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *DeliveryCreate) SetAttempts(v int) *DeliveryCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *DeliveryCreate) SetNillableAttempts(v *int) *DeliveryCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *DeliveryCreate) SetLastError(v string) *DeliveryCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *DeliveryCreate) SetNillableLastError(v *string) *DeliveryCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (_c *DeliveryCreate) SetNextRetryAt(v time.Time) *DeliveryCreate {
+	_c.mutation.SetNextRetryAt(v)
+	return _c
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (_c *DeliveryCreate) SetNillableNextRetryAt(v *time.Time) *DeliveryCreate {
+	if v != nil {
+		_c.SetNextRetryAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeliveryCreate) SetCreatedAt(v time.Time) *DeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DeliveryCreate) SetNillableCreatedAt(v *time.Time) *DeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DeliveryCreate) SetUpdatedAt(v time.Time) *DeliveryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DeliveryCreate) SetNillableUpdatedAt(v *time.Time) *DeliveryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *DeliveryCreate) SetPost(v *Post) *DeliveryCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// SetRevision sets the "revision" edge to the PostRevision entity.
+func (_c *DeliveryCreate) SetRevision(v *PostRevision) *DeliveryCreate {
+	return _c.SetRevisionID(v.ID)
+}
+
+// Mutation returns the DeliveryMutation object of the builder.
+func (_c *DeliveryCreate) Mutation() *DeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the Delivery in the database.
+func (_c *DeliveryCreate) Save(ctx context.Context) (*Delivery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeliveryCreate) SaveX(ctx context.Context) *Delivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeliveryCreate) defaults() {
+	if _, ok := _c.mutation.Target(); !ok {
+		v := delivery.DefaultTarget
+		_c.mutation.SetTarget(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := delivery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := delivery.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextRetryAt(); !ok {
+		v := delivery.DefaultNextRetryAt()
+		_c.mutation.SetNextRetryAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := delivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := delivery.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeliveryCreate) check() error {
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "Delivery.post_id"`)}
+	}
+	if v, ok := _c.mutation.PostID(); ok {
+		if err := delivery.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Delivery.post_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Consumer(); !ok {
+		return &ValidationError{Name: "consumer", err: errors.New(`ent: missing required field "Delivery.consumer"`)}
+	}
+	if v, ok := _c.mutation.Consumer(); ok {
+		if err := delivery.ConsumerValidator(v); err != nil {
+			return &ValidationError{Name: "consumer", err: fmt.Errorf(`ent: validator failed for field "Delivery.consumer": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "Delivery.target"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Delivery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := delivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Delivery.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Delivery.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextRetryAt(); !ok {
+		return &ValidationError{Name: "next_retry_at", err: errors.New(`ent: missing required field "Delivery.next_retry_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Delivery.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Delivery.updated_at"`)}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "Delivery.post"`)}
+	}
+	return nil
+}
+
+func (_c *DeliveryCreate) sqlSave(ctx context.Context) (*Delivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeliveryCreate) createSpec() (*Delivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Delivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(delivery.Table, sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Consumer(); ok {
+		_spec.SetField(delivery.FieldConsumer, field.TypeString, value)
+		_node.Consumer = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(delivery.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(delivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(delivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(delivery.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.NextRetryAt(); ok {
+		_spec.SetField(delivery.FieldNextRetryAt, field.TypeTime, value)
+		_node.NextRetryAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(delivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(delivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.PostTable,
+			Columns: []string{delivery.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.RevisionTable,
+			Columns: []string{delivery.RevisionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RevisionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeliveryCreateBulk is the builder for creating many Delivery entities in bulk.
+type DeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*DeliveryCreate
+}
+
+// Save creates the Delivery entities in the database.
+func (_c *DeliveryCreateBulk) Save(ctx context.Context) ([]*Delivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Delivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeliveryCreateBulk) SaveX(ctx context.Context) []*Delivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/delivery"
+	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/postrevision"
+	"github.com/wintbiit/rmtv/ent/predicate"
+)
+
+// DeliveryUpdate is the builder for updating Delivery entities.
+type DeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeliveryMutation
+}
+
+// Where appends a list predicates to the DeliveryUpdate builder.
+func (_u *DeliveryUpdate) Where(ps ...predicate.Delivery) *DeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *DeliveryUpdate) SetPostID(v string) *DeliveryUpdate {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillablePostID(v *string) *DeliveryUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetRevisionID sets the "revision_id" field.
+func (_u *DeliveryUpdate) SetRevisionID(v int) *DeliveryUpdate {
+	_u.mutation.SetRevisionID(v)
+	return _u
+}
+
+// SetNillableRevisionID sets the "revision_id" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillableRevisionID(v *int) *DeliveryUpdate {
+	if v != nil {
+		_u.SetRevisionID(*v)
+	}
+	return _u
+}
+
+// ClearRevisionID clears the value of the "revision_id" field.
+func (_u *DeliveryUpdate) ClearRevisionID() *DeliveryUpdate {
+	_u.mutation.ClearRevisionID()
+	return _u
+}
+
+// SetConsumer sets the "consumer" field.
+func (_u *DeliveryUpdate) SetConsumer(v string) *DeliveryUpdate {
+	_u.mutation.SetConsumer(v)
+	return _u
+}
+
+// SetNillableConsumer sets the "consumer" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillableConsumer(v *string) *DeliveryUpdate {
+	if v != nil {
+		_u.SetConsumer(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *DeliveryUpdate) SetTarget(v string) *DeliveryUpdate {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillableTarget(v *string) *DeliveryUpdate {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DeliveryUpdate) SetStatus(v delivery.Status) *DeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillableStatus(v *delivery.Status) *DeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DeliveryUpdate) SetAttempts(v int) *DeliveryUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillableAttempts(v *int) *DeliveryUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DeliveryUpdate) AddAttempts(v int) *DeliveryUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *DeliveryUpdate) SetLastError(v string) *DeliveryUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillableLastError(v *string) *DeliveryUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *DeliveryUpdate) ClearLastError() *DeliveryUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (_u *DeliveryUpdate) SetNextRetryAt(v time.Time) *DeliveryUpdate {
+	_u.mutation.SetNextRetryAt(v)
+	return _u
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillableNextRetryAt(v *time.Time) *DeliveryUpdate {
+	if v != nil {
+		_u.SetNextRetryAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DeliveryUpdate) SetCreatedAt(v time.Time) *DeliveryUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DeliveryUpdate) SetNillableCreatedAt(v *time.Time) *DeliveryUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeliveryUpdate) SetUpdatedAt(v time.Time) *DeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *DeliveryUpdate) SetPost(v *Post) *DeliveryUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// SetRevision sets the "revision" edge to the PostRevision entity.
+func (_u *DeliveryUpdate) SetRevision(v *PostRevision) *DeliveryUpdate {
+	return _u.SetRevisionID(v.ID)
+}
+
+// Mutation returns the DeliveryMutation object of the builder.
+func (_u *DeliveryUpdate) Mutation() *DeliveryMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *DeliveryUpdate) ClearPost() *DeliveryUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearRevision clears the "revision" edge to the PostRevision entity.
+func (_u *DeliveryUpdate) ClearRevision() *DeliveryUpdate {
+	_u.mutation.ClearRevision()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := delivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeliveryUpdate) check() error {
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := delivery.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Delivery.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Consumer(); ok {
+		if err := delivery.ConsumerValidator(v); err != nil {
+			return &ValidationError{Name: "consumer", err: fmt.Errorf(`ent: validator failed for field "Delivery.consumer": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := delivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Delivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Delivery.post"`)
+	}
+	return nil
+}
+
+func (_u *DeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(delivery.Table, delivery.Columns, sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Consumer(); ok {
+		_spec.SetField(delivery.FieldConsumer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(delivery.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(delivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(delivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(delivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(delivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(delivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextRetryAt(); ok {
+		_spec.SetField(delivery.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(delivery.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(delivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.PostTable,
+			Columns: []string{delivery.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.PostTable,
+			Columns: []string{delivery.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.RevisionTable,
+			Columns: []string{delivery.RevisionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.RevisionTable,
+			Columns: []string{delivery.RevisionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{delivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeliveryUpdateOne is the builder for updating a single Delivery entity.
+type DeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeliveryMutation
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *DeliveryUpdateOne) SetPostID(v string) *DeliveryUpdateOne {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillablePostID(v *string) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetRevisionID sets the "revision_id" field.
+func (_u *DeliveryUpdateOne) SetRevisionID(v int) *DeliveryUpdateOne {
+	_u.mutation.SetRevisionID(v)
+	return _u
+}
+
+// SetNillableRevisionID sets the "revision_id" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillableRevisionID(v *int) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetRevisionID(*v)
+	}
+	return _u
+}
+
+// ClearRevisionID clears the value of the "revision_id" field.
+func (_u *DeliveryUpdateOne) ClearRevisionID() *DeliveryUpdateOne {
+	_u.mutation.ClearRevisionID()
+	return _u
+}
+
+// SetConsumer sets the "consumer" field.
+func (_u *DeliveryUpdateOne) SetConsumer(v string) *DeliveryUpdateOne {
+	_u.mutation.SetConsumer(v)
+	return _u
+}
+
+// SetNillableConsumer sets the "consumer" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillableConsumer(v *string) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetConsumer(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *DeliveryUpdateOne) SetTarget(v string) *DeliveryUpdateOne {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillableTarget(v *string) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DeliveryUpdateOne) SetStatus(v delivery.Status) *DeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillableStatus(v *delivery.Status) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DeliveryUpdateOne) SetAttempts(v int) *DeliveryUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillableAttempts(v *int) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DeliveryUpdateOne) AddAttempts(v int) *DeliveryUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *DeliveryUpdateOne) SetLastError(v string) *DeliveryUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillableLastError(v *string) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *DeliveryUpdateOne) ClearLastError() *DeliveryUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (_u *DeliveryUpdateOne) SetNextRetryAt(v time.Time) *DeliveryUpdateOne {
+	_u.mutation.SetNextRetryAt(v)
+	return _u
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillableNextRetryAt(v *time.Time) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetNextRetryAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DeliveryUpdateOne) SetCreatedAt(v time.Time) *DeliveryUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DeliveryUpdateOne) SetNillableCreatedAt(v *time.Time) *DeliveryUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeliveryUpdateOne) SetUpdatedAt(v time.Time) *DeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *DeliveryUpdateOne) SetPost(v *Post) *DeliveryUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// SetRevision sets the "revision" edge to the PostRevision entity.
+func (_u *DeliveryUpdateOne) SetRevision(v *PostRevision) *DeliveryUpdateOne {
+	return _u.SetRevisionID(v.ID)
+}
+
+// Mutation returns the DeliveryMutation object of the builder.
+func (_u *DeliveryUpdateOne) Mutation() *DeliveryMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *DeliveryUpdateOne) ClearPost() *DeliveryUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearRevision clears the "revision" edge to the PostRevision entity.
+func (_u *DeliveryUpdateOne) ClearRevision() *DeliveryUpdateOne {
+	_u.mutation.ClearRevision()
+	return _u
+}
+
+// Where appends a list predicates to the DeliveryUpdate builder.
+func (_u *DeliveryUpdateOne) Where(ps ...predicate.Delivery) *DeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeliveryUpdateOne) Select(field string, fields ...string) *DeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Delivery entity.
+func (_u *DeliveryUpdateOne) Save(ctx context.Context) (*Delivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeliveryUpdateOne) SaveX(ctx context.Context) *Delivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := delivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := delivery.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Delivery.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Consumer(); ok {
+		if err := delivery.ConsumerValidator(v); err != nil {
+			return &ValidationError{Name: "consumer", err: fmt.Errorf(`ent: validator failed for field "Delivery.consumer": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := delivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Delivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Delivery.post"`)
+	}
+	return nil
+}
+
+func (_u *DeliveryUpdateOne) sqlSave(ctx context.Context) (_node *Delivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(delivery.Table, delivery.Columns, sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Delivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, delivery.FieldID)
+		for _, f := range fields {
+			if !delivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != delivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Consumer(); ok {
+		_spec.SetField(delivery.FieldConsumer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(delivery.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(delivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(delivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(delivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(delivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(delivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextRetryAt(); ok {
+		_spec.SetField(delivery.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(delivery.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(delivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.PostTable,
+			Columns: []string{delivery.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.PostTable,
+			Columns: []string{delivery.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.RevisionTable,
+			Columns: []string{delivery.RevisionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   delivery.RevisionTable,
+			Columns: []string{delivery.RevisionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Delivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{delivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
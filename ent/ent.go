@@ -12,7 +12,14 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/wintbiit/rmtv/ent/delivery"
+	"github.com/wintbiit/rmtv/ent/imagecache"
 	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/postrevision"
+	"github.com/wintbiit/rmtv/ent/pushedcard"
+	"github.com/wintbiit/rmtv/ent/savedpost"
+	"github.com/wintbiit/rmtv/ent/state"
+	"github.com/wintbiit/rmtv/ent/subscription"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +80,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			post.Table: post.ValidColumn,
+			delivery.Table:     delivery.ValidColumn,
+			imagecache.Table:   imagecache.ValidColumn,
+			post.Table:         post.ValidColumn,
+			postrevision.Table: postrevision.ValidColumn,
+			pushedcard.Table:   pushedcard.ValidColumn,
+			savedpost.Table:    savedpost.ValidColumn,
+			state.Table:        state.ValidColumn,
+			subscription.Table: subscription.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
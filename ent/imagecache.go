@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wintbiit/rmtv/ent/imagecache"
+)
+
+// ImageCache is the model entity for the ImageCache schema.
+type ImageCache struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 应用ID与图片地址的 sha256
+	ID string `json:"id,omitempty"`
+	// 上传图片的飞书应用ID
+	AppID string `json:"app_id,omitempty"`
+	// 图片地址
+	URL string `json:"url,omitempty"`
+	// 图片内容的 sha256
+	ContentHash string `json:"content_hash,omitempty"`
+	// 飞书 image_key
+	ImageKey string `json:"image_key,omitempty"`
+	// 上传时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImageCache) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case imagecache.FieldID, imagecache.FieldAppID, imagecache.FieldURL, imagecache.FieldContentHash, imagecache.FieldImageKey:
+			values[i] = new(sql.NullString)
+		case imagecache.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImageCache fields.
+func (_m *ImageCache) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case imagecache.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case imagecache.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				_m.AppID = value.String
+			}
+		case imagecache.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case imagecache.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = value.String
+			}
+		case imagecache.FieldImageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_key", values[i])
+			} else if value.Valid {
+				_m.ImageKey = value.String
+			}
+		case imagecache.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImageCache.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImageCache) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ImageCache.
+// Note that you need to call ImageCache.Unwrap() before calling this method if this ImageCache
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImageCache) Update() *ImageCacheUpdateOne {
+	return NewImageCacheClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImageCache entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImageCache) Unwrap() *ImageCache {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImageCache is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImageCache) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImageCache(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("app_id=")
+	builder.WriteString(_m.AppID)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(_m.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("image_key=")
+	builder.WriteString(_m.ImageKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImageCaches is a parsable slice of ImageCache.
+type ImageCaches []*ImageCache
@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package imagecache
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wintbiit/rmtv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContainsFold(FieldID, id))
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldAppID, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldURL, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ImageKey applies equality check predicate on the "image_key" field. It's identical to ImageKeyEQ.
+func ImageKey(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldImageKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNEQ(FieldAppID, v))
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldIn(FieldAppID, vs...))
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNotIn(FieldAppID, vs...))
+}
+
+// AppIDGT applies the GT predicate on the "app_id" field.
+func AppIDGT(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGT(FieldAppID, v))
+}
+
+// AppIDGTE applies the GTE predicate on the "app_id" field.
+func AppIDGTE(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGTE(FieldAppID, v))
+}
+
+// AppIDLT applies the LT predicate on the "app_id" field.
+func AppIDLT(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLT(FieldAppID, v))
+}
+
+// AppIDLTE applies the LTE predicate on the "app_id" field.
+func AppIDLTE(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLTE(FieldAppID, v))
+}
+
+// AppIDContains applies the Contains predicate on the "app_id" field.
+func AppIDContains(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContains(FieldAppID, v))
+}
+
+// AppIDHasPrefix applies the HasPrefix predicate on the "app_id" field.
+func AppIDHasPrefix(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldHasPrefix(FieldAppID, v))
+}
+
+// AppIDHasSuffix applies the HasSuffix predicate on the "app_id" field.
+func AppIDHasSuffix(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldHasSuffix(FieldAppID, v))
+}
+
+// AppIDEqualFold applies the EqualFold predicate on the "app_id" field.
+func AppIDEqualFold(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEqualFold(FieldAppID, v))
+}
+
+// AppIDContainsFold applies the ContainsFold predicate on the "app_id" field.
+func AppIDContainsFold(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContainsFold(FieldAppID, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContainsFold(FieldURL, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// ImageKeyEQ applies the EQ predicate on the "image_key" field.
+func ImageKeyEQ(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldImageKey, v))
+}
+
+// ImageKeyNEQ applies the NEQ predicate on the "image_key" field.
+func ImageKeyNEQ(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNEQ(FieldImageKey, v))
+}
+
+// ImageKeyIn applies the In predicate on the "image_key" field.
+func ImageKeyIn(vs ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldIn(FieldImageKey, vs...))
+}
+
+// ImageKeyNotIn applies the NotIn predicate on the "image_key" field.
+func ImageKeyNotIn(vs ...string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNotIn(FieldImageKey, vs...))
+}
+
+// ImageKeyGT applies the GT predicate on the "image_key" field.
+func ImageKeyGT(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGT(FieldImageKey, v))
+}
+
+// ImageKeyGTE applies the GTE predicate on the "image_key" field.
+func ImageKeyGTE(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGTE(FieldImageKey, v))
+}
+
+// ImageKeyLT applies the LT predicate on the "image_key" field.
+func ImageKeyLT(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLT(FieldImageKey, v))
+}
+
+// ImageKeyLTE applies the LTE predicate on the "image_key" field.
+func ImageKeyLTE(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLTE(FieldImageKey, v))
+}
+
+// ImageKeyContains applies the Contains predicate on the "image_key" field.
+func ImageKeyContains(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContains(FieldImageKey, v))
+}
+
+// ImageKeyHasPrefix applies the HasPrefix predicate on the "image_key" field.
+func ImageKeyHasPrefix(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldHasPrefix(FieldImageKey, v))
+}
+
+// ImageKeyHasSuffix applies the HasSuffix predicate on the "image_key" field.
+func ImageKeyHasSuffix(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldHasSuffix(FieldImageKey, v))
+}
+
+// ImageKeyEqualFold applies the EqualFold predicate on the "image_key" field.
+func ImageKeyEqualFold(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEqualFold(FieldImageKey, v))
+}
+
+// ImageKeyContainsFold applies the ContainsFold predicate on the "image_key" field.
+func ImageKeyContainsFold(v string) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldContainsFold(FieldImageKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ImageCache {
+	return predicate.ImageCache(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImageCache) predicate.ImageCache {
+	return predicate.ImageCache(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImageCache) predicate.ImageCache {
+	return predicate.ImageCache(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImageCache) predicate.ImageCache {
+	return predicate.ImageCache(sql.NotPredicates(p))
+}
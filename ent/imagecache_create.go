@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/imagecache"
+)
+
+// ImageCacheCreate is the builder for creating a ImageCache entity.
+type ImageCacheCreate struct {
+	config
+	mutation *ImageCacheMutation
+	hooks    []Hook
+}
+
+// SetAppID sets the "app_id" field.
+func (_c *ImageCacheCreate) SetAppID(v string) *ImageCacheCreate {
+	_c.mutation.SetAppID(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *ImageCacheCreate) SetURL(v string) *ImageCacheCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *ImageCacheCreate) SetContentHash(v string) *ImageCacheCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetImageKey sets the "image_key" field.
+func (_c *ImageCacheCreate) SetImageKey(v string) *ImageCacheCreate {
+	_c.mutation.SetImageKey(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImageCacheCreate) SetCreatedAt(v time.Time) *ImageCacheCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImageCacheCreate) SetNillableCreatedAt(v *time.Time) *ImageCacheCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImageCacheCreate) SetID(v string) *ImageCacheCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ImageCacheMutation object of the builder.
+func (_c *ImageCacheCreate) Mutation() *ImageCacheMutation {
+	return _c.mutation
+}
+
+// Save creates the ImageCache in the database.
+func (_c *ImageCacheCreate) Save(ctx context.Context) (*ImageCache, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImageCacheCreate) SaveX(ctx context.Context) *ImageCache {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImageCacheCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImageCacheCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImageCacheCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := imagecache.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImageCacheCreate) check() error {
+	if _, ok := _c.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "ImageCache.app_id"`)}
+	}
+	if v, ok := _c.mutation.AppID(); ok {
+		if err := imagecache.AppIDValidator(v); err != nil {
+			return &ValidationError{Name: "app_id", err: fmt.Errorf(`ent: validator failed for field "ImageCache.app_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "ImageCache.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := imagecache.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ImageCache.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "ImageCache.content_hash"`)}
+	}
+	if v, ok := _c.mutation.ContentHash(); ok {
+		if err := imagecache.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ImageCache.content_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ImageKey(); !ok {
+		return &ValidationError{Name: "image_key", err: errors.New(`ent: missing required field "ImageCache.image_key"`)}
+	}
+	if v, ok := _c.mutation.ImageKey(); ok {
+		if err := imagecache.ImageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "image_key", err: fmt.Errorf(`ent: validator failed for field "ImageCache.image_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImageCache.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := imagecache.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ImageCache.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ImageCacheCreate) sqlSave(ctx context.Context) (*ImageCache, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ImageCache.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImageCacheCreate) createSpec() (*ImageCache, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImageCache{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(imagecache.Table, sqlgraph.NewFieldSpec(imagecache.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.AppID(); ok {
+		_spec.SetField(imagecache.FieldAppID, field.TypeString, value)
+		_node.AppID = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(imagecache.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(imagecache.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := _c.mutation.ImageKey(); ok {
+		_spec.SetField(imagecache.FieldImageKey, field.TypeString, value)
+		_node.ImageKey = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(imagecache.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ImageCacheCreateBulk is the builder for creating many ImageCache entities in bulk.
+type ImageCacheCreateBulk struct {
+	config
+	err      error
+	builders []*ImageCacheCreate
+}
+
+// Save creates the ImageCache entities in the database.
+func (_c *ImageCacheCreateBulk) Save(ctx context.Context) ([]*ImageCache, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImageCache, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImageCacheMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImageCacheCreateBulk) SaveX(ctx context.Context) []*ImageCache {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImageCacheCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImageCacheCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/imagecache"
+	"github.com/wintbiit/rmtv/ent/predicate"
+)
+
+// ImageCacheUpdate is the builder for updating ImageCache entities.
+type ImageCacheUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImageCacheMutation
+}
+
+// Where appends a list predicates to the ImageCacheUpdate builder.
+func (_u *ImageCacheUpdate) Where(ps ...predicate.ImageCache) *ImageCacheUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *ImageCacheUpdate) SetAppID(v string) *ImageCacheUpdate {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *ImageCacheUpdate) SetNillableAppID(v *string) *ImageCacheUpdate {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ImageCacheUpdate) SetURL(v string) *ImageCacheUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ImageCacheUpdate) SetNillableURL(v *string) *ImageCacheUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ImageCacheUpdate) SetContentHash(v string) *ImageCacheUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ImageCacheUpdate) SetNillableContentHash(v *string) *ImageCacheUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetImageKey sets the "image_key" field.
+func (_u *ImageCacheUpdate) SetImageKey(v string) *ImageCacheUpdate {
+	_u.mutation.SetImageKey(v)
+	return _u
+}
+
+// SetNillableImageKey sets the "image_key" field if the given value is not nil.
+func (_u *ImageCacheUpdate) SetNillableImageKey(v *string) *ImageCacheUpdate {
+	if v != nil {
+		_u.SetImageKey(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ImageCacheUpdate) SetCreatedAt(v time.Time) *ImageCacheUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ImageCacheUpdate) SetNillableCreatedAt(v *time.Time) *ImageCacheUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ImageCacheMutation object of the builder.
+func (_u *ImageCacheUpdate) Mutation() *ImageCacheMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImageCacheUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImageCacheUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImageCacheUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImageCacheUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImageCacheUpdate) check() error {
+	if v, ok := _u.mutation.AppID(); ok {
+		if err := imagecache.AppIDValidator(v); err != nil {
+			return &ValidationError{Name: "app_id", err: fmt.Errorf(`ent: validator failed for field "ImageCache.app_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := imagecache.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ImageCache.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := imagecache.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ImageCache.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ImageKey(); ok {
+		if err := imagecache.ImageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "image_key", err: fmt.Errorf(`ent: validator failed for field "ImageCache.image_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImageCacheUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(imagecache.Table, imagecache.Columns, sqlgraph.NewFieldSpec(imagecache.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AppID(); ok {
+		_spec.SetField(imagecache.FieldAppID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(imagecache.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(imagecache.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ImageKey(); ok {
+		_spec.SetField(imagecache.FieldImageKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(imagecache.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{imagecache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImageCacheUpdateOne is the builder for updating a single ImageCache entity.
+type ImageCacheUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImageCacheMutation
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *ImageCacheUpdateOne) SetAppID(v string) *ImageCacheUpdateOne {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *ImageCacheUpdateOne) SetNillableAppID(v *string) *ImageCacheUpdateOne {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ImageCacheUpdateOne) SetURL(v string) *ImageCacheUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ImageCacheUpdateOne) SetNillableURL(v *string) *ImageCacheUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ImageCacheUpdateOne) SetContentHash(v string) *ImageCacheUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ImageCacheUpdateOne) SetNillableContentHash(v *string) *ImageCacheUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetImageKey sets the "image_key" field.
+func (_u *ImageCacheUpdateOne) SetImageKey(v string) *ImageCacheUpdateOne {
+	_u.mutation.SetImageKey(v)
+	return _u
+}
+
+// SetNillableImageKey sets the "image_key" field if the given value is not nil.
+func (_u *ImageCacheUpdateOne) SetNillableImageKey(v *string) *ImageCacheUpdateOne {
+	if v != nil {
+		_u.SetImageKey(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ImageCacheUpdateOne) SetCreatedAt(v time.Time) *ImageCacheUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ImageCacheUpdateOne) SetNillableCreatedAt(v *time.Time) *ImageCacheUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ImageCacheMutation object of the builder.
+func (_u *ImageCacheUpdateOne) Mutation() *ImageCacheMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ImageCacheUpdate builder.
+func (_u *ImageCacheUpdateOne) Where(ps ...predicate.ImageCache) *ImageCacheUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImageCacheUpdateOne) Select(field string, fields ...string) *ImageCacheUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImageCache entity.
+func (_u *ImageCacheUpdateOne) Save(ctx context.Context) (*ImageCache, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImageCacheUpdateOne) SaveX(ctx context.Context) *ImageCache {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImageCacheUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImageCacheUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImageCacheUpdateOne) check() error {
+	if v, ok := _u.mutation.AppID(); ok {
+		if err := imagecache.AppIDValidator(v); err != nil {
+			return &ValidationError{Name: "app_id", err: fmt.Errorf(`ent: validator failed for field "ImageCache.app_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := imagecache.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ImageCache.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := imagecache.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ImageCache.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ImageKey(); ok {
+		if err := imagecache.ImageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "image_key", err: fmt.Errorf(`ent: validator failed for field "ImageCache.image_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImageCacheUpdateOne) sqlSave(ctx context.Context) (_node *ImageCache, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(imagecache.Table, imagecache.Columns, sqlgraph.NewFieldSpec(imagecache.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImageCache.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, imagecache.FieldID)
+		for _, f := range fields {
+			if !imagecache.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != imagecache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AppID(); ok {
+		_spec.SetField(imagecache.FieldAppID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(imagecache.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(imagecache.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ImageKey(); ok {
+		_spec.SetField(imagecache.FieldImageKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(imagecache.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &ImageCache{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{imagecache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,11 +3,93 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// DeliveriesColumns holds the columns for the "deliveries" table.
+	DeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "consumer", Type: field.TypeString},
+		{Name: "target", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "delivered", "failed", "skipped"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "next_retry_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeString},
+		{Name: "revision_id", Type: field.TypeInt, Nullable: true},
+	}
+	// DeliveriesTable holds the schema information for the "deliveries" table.
+	DeliveriesTable = &schema.Table{
+		Name:       "deliveries",
+		Columns:    DeliveriesColumns,
+		PrimaryKey: []*schema.Column{DeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deliveries_posts_deliveries",
+				Columns:    []*schema.Column{DeliveriesColumns[9]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "deliveries_post_revisions_deliveries",
+				Columns:    []*schema.Column{DeliveriesColumns[10]},
+				RefColumns: []*schema.Column{PostRevisionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "delivery_post_id_consumer_target_revision_id",
+				Unique:  true,
+				Columns: []*schema.Column{DeliveriesColumns[9], DeliveriesColumns[1], DeliveriesColumns[2], DeliveriesColumns[10]},
+			},
+			{
+				Name:    "delivery_post_id_consumer_target",
+				Unique:  true,
+				Columns: []*schema.Column{DeliveriesColumns[9], DeliveriesColumns[1], DeliveriesColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "revision_id IS NULL",
+				},
+			},
+			{
+				Name:    "delivery_status_next_retry_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveriesColumns[3], DeliveriesColumns[6]},
+			},
+		},
+	}
+	// ImageCachesColumns holds the columns for the "image_caches" table.
+	ImageCachesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "app_id", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "content_hash", Type: field.TypeString},
+		{Name: "image_key", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ImageCachesTable holds the schema information for the "image_caches" table.
+	ImageCachesTable = &schema.Table{
+		Name:       "image_caches",
+		Columns:    ImageCachesColumns,
+		PrimaryKey: []*schema.Column{ImageCachesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "imagecache_app_id_content_hash",
+				Unique:  false,
+				Columns: []*schema.Column{ImageCachesColumns[1], ImageCachesColumns[3]},
+			},
+			{
+				Name:    "imagecache_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImageCachesColumns[5]},
+			},
+		},
+	}
 	// PostsColumns holds the columns for the "posts" table.
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -15,12 +97,18 @@ var (
 		{Name: "picture", Type: field.TypeString, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "content_markdown", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "tags", Type: field.TypeJSON},
 		{Name: "pub_date", Type: field.TypeTime},
 		{Name: "author", Type: field.TypeString},
 		{Name: "author_url", Type: field.TypeString},
 		{Name: "url", Type: field.TypeString},
 		{Name: "extra", Type: field.TypeJSON},
+		{Name: "type", Type: field.TypeString, Default: ""},
+		{Name: "type_color", Type: field.TypeString, Default: ""},
+		{Name: "extra_text", Type: field.TypeString, Default: ""},
+		{Name: "snapshot", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -29,12 +117,129 @@ var (
 		Name:       "posts",
 		Columns:    PostsColumns,
 		PrimaryKey: []*schema.Column{PostsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "post_source_id",
+				Unique:  true,
+				Columns: []*schema.Column{PostsColumns[1], PostsColumns[0]},
+			},
+		},
+	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeString},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[3]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PushedCardsColumns holds the columns for the "pushed_cards" table.
+	PushedCardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "chat_id", Type: field.TypeString},
+		{Name: "posts", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PushedCardsTable holds the schema information for the "pushed_cards" table.
+	PushedCardsTable = &schema.Table{
+		Name:       "pushed_cards",
+		Columns:    PushedCardsColumns,
+		PrimaryKey: []*schema.Column{PushedCardsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pushedcard_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PushedCardsColumns[3]},
+			},
+		},
+	}
+	// SavedPostsColumns holds the columns for the "saved_posts" table.
+	SavedPostsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "chat_id", Type: field.TypeString},
+		{Name: "saved_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeString},
+	}
+	// SavedPostsTable holds the schema information for the "saved_posts" table.
+	SavedPostsTable = &schema.Table{
+		Name:       "saved_posts",
+		Columns:    SavedPostsColumns,
+		PrimaryKey: []*schema.Column{SavedPostsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_posts_posts_saves",
+				Columns:    []*schema.Column{SavedPostsColumns[4]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedpost_chat_id_post_id",
+				Unique:  true,
+				Columns: []*schema.Column{SavedPostsColumns[1], SavedPostsColumns[4]},
+			},
+		},
+	}
+	// StatesColumns holds the columns for the "states" table.
+	StatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "value", Type: field.TypeJSON},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// StatesTable holds the schema information for the "states" table.
+	StatesTable = &schema.Table{
+		Name:       "states",
+		Columns:    StatesColumns,
+		PrimaryKey: []*schema.Column{StatesColumns[0]},
+	}
+	// SubscriptionsColumns holds the columns for the "subscriptions" table.
+	SubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "sources", Type: field.TypeJSON},
+		{Name: "muted_sources", Type: field.TypeJSON},
+		{Name: "keywords", Type: field.TypeJSON},
+		{Name: "muted_authors", Type: field.TypeJSON},
+		{Name: "muted_tags", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SubscriptionsTable holds the schema information for the "subscriptions" table.
+	SubscriptionsTable = &schema.Table{
+		Name:       "subscriptions",
+		Columns:    SubscriptionsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionsColumns[0]},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DeliveriesTable,
+		ImageCachesTable,
 		PostsTable,
+		PostRevisionsTable,
+		PushedCardsTable,
+		SavedPostsTable,
+		StatesTable,
+		SubscriptionsTable,
 	}
 )
 
 func init() {
+	DeliveriesTable.ForeignKeys[0].RefTable = PostsTable
+	DeliveriesTable.ForeignKeys[1].RefTable = PostRevisionsTable
+	PostRevisionsTable.ForeignKeys[0].RefTable = PostsTable
+	SavedPostsTable.ForeignKeys[0].RefTable = PostsTable
 }
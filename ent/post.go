@@ -27,6 +27,10 @@ type Post struct {
 	Title string `json:"title,omitempty"`
 	// 描述
 	Description string `json:"description,omitempty"`
+	// 正文HTML
+	Content string `json:"content,omitempty"`
+	// 正文Markdown
+	ContentMarkdown string `json:"content_markdown,omitempty"`
 	// 标签
 	Tags []string `json:"tags,omitempty"`
 	// 发布时间
@@ -39,21 +43,72 @@ type Post struct {
 	URL string `json:"url,omitempty"`
 	// 额外信息
 	Extra any `json:"extra,omitempty"`
+	// 类型
+	Type string `json:"type,omitempty"`
+	// 类型颜色
+	TypeColor string `json:"type_color,omitempty"`
+	// 额外信息文本
+	ExtraText string `json:"extra_text,omitempty"`
+	// 跟踪字段快照
+	Snapshot map[string]string `json:"snapshot,omitempty"`
 	// 创建时间
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostQuery when eager-loading is set.
+	Edges        PostEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// PostEdges holds the relations/edges for other nodes in the graph.
+type PostEdges struct {
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*Delivery `json:"deliveries,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
+	// Saves holds the value of the saves edge.
+	Saves []*SavedPost `json:"saves,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) DeliveriesOrErr() ([]*Delivery, error) {
+	if e.loadedTypes[0] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[1] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// SavesOrErr returns the Saves value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) SavesOrErr() ([]*SavedPost, error) {
+	if e.loadedTypes[2] {
+		return e.Saves, nil
+	}
+	return nil, &NotLoadedError{edge: "saves"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case post.FieldTags, post.FieldExtra:
+		case post.FieldTags, post.FieldExtra, post.FieldSnapshot:
 			values[i] = new([]byte)
-		case post.FieldID, post.FieldSource, post.FieldPicture, post.FieldTitle, post.FieldDescription, post.FieldAuthor, post.FieldAuthorURL, post.FieldURL:
+		case post.FieldID, post.FieldSource, post.FieldPicture, post.FieldTitle, post.FieldDescription, post.FieldContent, post.FieldContentMarkdown, post.FieldAuthor, post.FieldAuthorURL, post.FieldURL, post.FieldType, post.FieldTypeColor, post.FieldExtraText:
 			values[i] = new(sql.NullString)
 		case post.FieldPubDate, post.FieldCreatedAt, post.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -103,6 +158,18 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case post.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case post.FieldContentMarkdown:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_markdown", values[i])
+			} else if value.Valid {
+				_m.ContentMarkdown = value.String
+			}
 		case post.FieldTags:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field tags", values[i])
@@ -143,6 +210,32 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field extra: %w", err)
 				}
 			}
+		case post.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case post.FieldTypeColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type_color", values[i])
+			} else if value.Valid {
+				_m.TypeColor = value.String
+			}
+		case post.FieldExtraText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field extra_text", values[i])
+			} else if value.Valid {
+				_m.ExtraText = value.String
+			}
+		case post.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
 		case post.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -168,6 +261,21 @@ func (_m *Post) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryDeliveries queries the "deliveries" edge of the Post entity.
+func (_m *Post) QueryDeliveries() *DeliveryQuery {
+	return NewPostClient(_m.config).QueryDeliveries(_m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Post entity.
+func (_m *Post) QueryRevisions() *PostRevisionQuery {
+	return NewPostClient(_m.config).QueryRevisions(_m)
+}
+
+// QuerySaves queries the "saves" edge of the Post entity.
+func (_m *Post) QuerySaves() *SavedPostQuery {
+	return NewPostClient(_m.config).QuerySaves(_m)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -205,6 +313,12 @@ func (_m *Post) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("content_markdown=")
+	builder.WriteString(_m.ContentMarkdown)
+	builder.WriteString(", ")
 	builder.WriteString("tags=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
 	builder.WriteString(", ")
@@ -223,6 +337,18 @@ func (_m *Post) String() string {
 	builder.WriteString("extra=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Extra))
 	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("type_color=")
+	builder.WriteString(_m.TypeColor)
+	builder.WriteString(", ")
+	builder.WriteString("extra_text=")
+	builder.WriteString(_m.ExtraText)
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Snapshot))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
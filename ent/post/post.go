@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -21,6 +22,10 @@ const (
 	FieldTitle = "title"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldContentMarkdown holds the string denoting the content_markdown field in the database.
+	FieldContentMarkdown = "content_markdown"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
 	// FieldPubDate holds the string denoting the pub_date field in the database.
@@ -33,12 +38,47 @@ const (
 	FieldURL = "url"
 	// FieldExtra holds the string denoting the extra field in the database.
 	FieldExtra = "extra"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldTypeColor holds the string denoting the type_color field in the database.
+	FieldTypeColor = "type_color"
+	// FieldExtraText holds the string denoting the extra_text field in the database.
+	FieldExtraText = "extra_text"
+	// FieldSnapshot holds the string denoting the snapshot field in the database.
+	FieldSnapshot = "snapshot"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// EdgeDeliveries holds the string denoting the deliveries edge name in mutations.
+	EdgeDeliveries = "deliveries"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeSaves holds the string denoting the saves edge name in mutations.
+	EdgeSaves = "saves"
 	// Table holds the table name of the post in the database.
 	Table = "posts"
+	// DeliveriesTable is the table that holds the deliveries relation/edge.
+	DeliveriesTable = "deliveries"
+	// DeliveriesInverseTable is the table name for the Delivery entity.
+	// It exists in this package in order to avoid circular dependency with the "delivery" package.
+	DeliveriesInverseTable = "deliveries"
+	// DeliveriesColumn is the table column denoting the deliveries relation/edge.
+	DeliveriesColumn = "post_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "post_revisions"
+	// RevisionsInverseTable is the table name for the PostRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "postrevision" package.
+	RevisionsInverseTable = "post_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "post_id"
+	// SavesTable is the table that holds the saves relation/edge.
+	SavesTable = "saved_posts"
+	// SavesInverseTable is the table name for the SavedPost entity.
+	// It exists in this package in order to avoid circular dependency with the "savedpost" package.
+	SavesInverseTable = "saved_posts"
+	// SavesColumn is the table column denoting the saves relation/edge.
+	SavesColumn = "post_id"
 )
 
 // Columns holds all SQL columns for post fields.
@@ -48,12 +88,18 @@ var Columns = []string{
 	FieldPicture,
 	FieldTitle,
 	FieldDescription,
+	FieldContent,
+	FieldContentMarkdown,
 	FieldTags,
 	FieldPubDate,
 	FieldAuthor,
 	FieldAuthorURL,
 	FieldURL,
 	FieldExtra,
+	FieldType,
+	FieldTypeColor,
+	FieldExtraText,
+	FieldSnapshot,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -73,6 +119,16 @@ var (
 	SourceValidator func(string) error
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// DefaultContent holds the default value on creation for the "content" field.
+	DefaultContent string
+	// DefaultContentMarkdown holds the default value on creation for the "content_markdown" field.
+	DefaultContentMarkdown string
+	// DefaultType holds the default value on creation for the "type" field.
+	DefaultType string
+	// DefaultTypeColor holds the default value on creation for the "type_color" field.
+	DefaultTypeColor string
+	// DefaultExtraText holds the default value on creation for the "extra_text" field.
+	DefaultExtraText string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -109,6 +165,16 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByContentMarkdown orders the results by the content_markdown field.
+func ByContentMarkdown(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentMarkdown, opts...).ToFunc()
+}
+
 // ByPubDate orders the results by the pub_date field.
 func ByPubDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPubDate, opts...).ToFunc()
@@ -129,6 +195,21 @@ func ByURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldURL, opts...).ToFunc()
 }
 
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByTypeColor orders the results by the type_color field.
+func ByTypeColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTypeColor, opts...).ToFunc()
+}
+
+// ByExtraText orders the results by the extra_text field.
+func ByExtraText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtraText, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -138,3 +219,66 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
+
+// ByDeliveriesCount orders the results by deliveries count.
+func ByDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeliveriesStep(), opts...)
+	}
+}
+
+// ByDeliveries orders the results by deliveries terms.
+func ByDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavesCount orders the results by saves count.
+func BySavesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavesStep(), opts...)
+	}
+}
+
+// BySaves orders the results by saves terms.
+func BySaves(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeliveriesTable, DeliveriesColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newSavesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavesTable, SavesColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/wintbiit/rmtv/ent/predicate"
 )
 
@@ -84,6 +85,16 @@ func Description(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldDescription, v))
 }
 
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentMarkdown applies equality check predicate on the "content_markdown" field. It's identical to ContentMarkdownEQ.
+func ContentMarkdown(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldContentMarkdown, v))
+}
+
 // PubDate applies equality check predicate on the "pub_date" field. It's identical to PubDateEQ.
 func PubDate(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldPubDate, v))
@@ -104,6 +115,21 @@ func URL(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldURL, v))
 }
 
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldType, v))
+}
+
+// TypeColor applies equality check predicate on the "type_color" field. It's identical to TypeColorEQ.
+func TypeColor(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldTypeColor, v))
+}
+
+// ExtraText applies equality check predicate on the "extra_text" field. It's identical to ExtraTextEQ.
+func ExtraText(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldExtraText, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldCreatedAt, v))
@@ -384,6 +410,136 @@ func DescriptionContainsFold(v string) predicate.Post {
 	return predicate.Post(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ContentMarkdownEQ applies the EQ predicate on the "content_markdown" field.
+func ContentMarkdownEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownNEQ applies the NEQ predicate on the "content_markdown" field.
+func ContentMarkdownNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownIn applies the In predicate on the "content_markdown" field.
+func ContentMarkdownIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldContentMarkdown, vs...))
+}
+
+// ContentMarkdownNotIn applies the NotIn predicate on the "content_markdown" field.
+func ContentMarkdownNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldContentMarkdown, vs...))
+}
+
+// ContentMarkdownGT applies the GT predicate on the "content_markdown" field.
+func ContentMarkdownGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownGTE applies the GTE predicate on the "content_markdown" field.
+func ContentMarkdownGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownLT applies the LT predicate on the "content_markdown" field.
+func ContentMarkdownLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownLTE applies the LTE predicate on the "content_markdown" field.
+func ContentMarkdownLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownContains applies the Contains predicate on the "content_markdown" field.
+func ContentMarkdownContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownHasPrefix applies the HasPrefix predicate on the "content_markdown" field.
+func ContentMarkdownHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownHasSuffix applies the HasSuffix predicate on the "content_markdown" field.
+func ContentMarkdownHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownEqualFold applies the EqualFold predicate on the "content_markdown" field.
+func ContentMarkdownEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldContentMarkdown, v))
+}
+
+// ContentMarkdownContainsFold applies the ContainsFold predicate on the "content_markdown" field.
+func ContentMarkdownContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldContentMarkdown, v))
+}
+
 // PubDateEQ applies the EQ predicate on the "pub_date" field.
 func PubDateEQ(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldPubDate, v))
@@ -619,6 +775,211 @@ func URLContainsFold(v string) predicate.Post {
 	return predicate.Post(sql.FieldContainsFold(FieldURL, v))
 }
 
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldType, v))
+}
+
+// TypeColorEQ applies the EQ predicate on the "type_color" field.
+func TypeColorEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldTypeColor, v))
+}
+
+// TypeColorNEQ applies the NEQ predicate on the "type_color" field.
+func TypeColorNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldTypeColor, v))
+}
+
+// TypeColorIn applies the In predicate on the "type_color" field.
+func TypeColorIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldTypeColor, vs...))
+}
+
+// TypeColorNotIn applies the NotIn predicate on the "type_color" field.
+func TypeColorNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldTypeColor, vs...))
+}
+
+// TypeColorGT applies the GT predicate on the "type_color" field.
+func TypeColorGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldTypeColor, v))
+}
+
+// TypeColorGTE applies the GTE predicate on the "type_color" field.
+func TypeColorGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldTypeColor, v))
+}
+
+// TypeColorLT applies the LT predicate on the "type_color" field.
+func TypeColorLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldTypeColor, v))
+}
+
+// TypeColorLTE applies the LTE predicate on the "type_color" field.
+func TypeColorLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldTypeColor, v))
+}
+
+// TypeColorContains applies the Contains predicate on the "type_color" field.
+func TypeColorContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldTypeColor, v))
+}
+
+// TypeColorHasPrefix applies the HasPrefix predicate on the "type_color" field.
+func TypeColorHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldTypeColor, v))
+}
+
+// TypeColorHasSuffix applies the HasSuffix predicate on the "type_color" field.
+func TypeColorHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldTypeColor, v))
+}
+
+// TypeColorEqualFold applies the EqualFold predicate on the "type_color" field.
+func TypeColorEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldTypeColor, v))
+}
+
+// TypeColorContainsFold applies the ContainsFold predicate on the "type_color" field.
+func TypeColorContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldTypeColor, v))
+}
+
+// ExtraTextEQ applies the EQ predicate on the "extra_text" field.
+func ExtraTextEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldExtraText, v))
+}
+
+// ExtraTextNEQ applies the NEQ predicate on the "extra_text" field.
+func ExtraTextNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldExtraText, v))
+}
+
+// ExtraTextIn applies the In predicate on the "extra_text" field.
+func ExtraTextIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldExtraText, vs...))
+}
+
+// ExtraTextNotIn applies the NotIn predicate on the "extra_text" field.
+func ExtraTextNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldExtraText, vs...))
+}
+
+// ExtraTextGT applies the GT predicate on the "extra_text" field.
+func ExtraTextGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldExtraText, v))
+}
+
+// ExtraTextGTE applies the GTE predicate on the "extra_text" field.
+func ExtraTextGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldExtraText, v))
+}
+
+// ExtraTextLT applies the LT predicate on the "extra_text" field.
+func ExtraTextLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldExtraText, v))
+}
+
+// ExtraTextLTE applies the LTE predicate on the "extra_text" field.
+func ExtraTextLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldExtraText, v))
+}
+
+// ExtraTextContains applies the Contains predicate on the "extra_text" field.
+func ExtraTextContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldExtraText, v))
+}
+
+// ExtraTextHasPrefix applies the HasPrefix predicate on the "extra_text" field.
+func ExtraTextHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldExtraText, v))
+}
+
+// ExtraTextHasSuffix applies the HasSuffix predicate on the "extra_text" field.
+func ExtraTextHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldExtraText, v))
+}
+
+// ExtraTextEqualFold applies the EqualFold predicate on the "extra_text" field.
+func ExtraTextEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldExtraText, v))
+}
+
+// ExtraTextContainsFold applies the ContainsFold predicate on the "extra_text" field.
+func ExtraTextContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldExtraText, v))
+}
+
+// SnapshotIsNil applies the IsNil predicate on the "snapshot" field.
+func SnapshotIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldSnapshot))
+}
+
+// SnapshotNotNil applies the NotNil predicate on the "snapshot" field.
+func SnapshotNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldSnapshot))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldCreatedAt, v))
@@ -699,6 +1060,75 @@ func UpdatedAtLTE(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// HasDeliveries applies the HasEdge predicate on the "deliveries" edge.
+func HasDeliveries() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeliveriesTable, DeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeliveriesWith applies the HasEdge predicate on the "deliveries" edge with a given conditions (other predicates).
+func HasDeliveriesWith(preds ...predicate.Delivery) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.PostRevision) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSaves applies the HasEdge predicate on the "saves" edge.
+func HasSaves() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavesTable, SavesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavesWith applies the HasEdge predicate on the "saves" edge with a given conditions (other predicates).
+func HasSavesWith(preds ...predicate.SavedPost) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newSavesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Post) predicate.Post {
 	return predicate.Post(sql.AndPredicates(predicates...))
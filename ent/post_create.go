@@ -10,7 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/delivery"
 	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/postrevision"
+	"github.com/wintbiit/rmtv/ent/savedpost"
 )
 
 // PostCreate is the builder for creating a Post entity.
@@ -52,6 +55,34 @@ func (_c *PostCreate) SetDescription(v string) *PostCreate {
 	return _c
 }
 
+// SetContent sets the "content" field.
+func (_c *PostCreate) SetContent(v string) *PostCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *PostCreate) SetNillableContent(v *string) *PostCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetContentMarkdown sets the "content_markdown" field.
+func (_c *PostCreate) SetContentMarkdown(v string) *PostCreate {
+	_c.mutation.SetContentMarkdown(v)
+	return _c
+}
+
+// SetNillableContentMarkdown sets the "content_markdown" field if the given value is not nil.
+func (_c *PostCreate) SetNillableContentMarkdown(v *string) *PostCreate {
+	if v != nil {
+		_c.SetContentMarkdown(*v)
+	}
+	return _c
+}
+
 // SetTags sets the "tags" field.
 func (_c *PostCreate) SetTags(v []string) *PostCreate {
 	_c.mutation.SetTags(v)
@@ -88,6 +119,54 @@ func (_c *PostCreate) SetExtra(v any) *PostCreate {
 	return _c
 }
 
+// SetType sets the "type" field.
+func (_c *PostCreate) SetType(v string) *PostCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *PostCreate) SetNillableType(v *string) *PostCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetTypeColor sets the "type_color" field.
+func (_c *PostCreate) SetTypeColor(v string) *PostCreate {
+	_c.mutation.SetTypeColor(v)
+	return _c
+}
+
+// SetNillableTypeColor sets the "type_color" field if the given value is not nil.
+func (_c *PostCreate) SetNillableTypeColor(v *string) *PostCreate {
+	if v != nil {
+		_c.SetTypeColor(*v)
+	}
+	return _c
+}
+
+// SetExtraText sets the "extra_text" field.
+func (_c *PostCreate) SetExtraText(v string) *PostCreate {
+	_c.mutation.SetExtraText(v)
+	return _c
+}
+
+// SetNillableExtraText sets the "extra_text" field if the given value is not nil.
+func (_c *PostCreate) SetNillableExtraText(v *string) *PostCreate {
+	if v != nil {
+		_c.SetExtraText(*v)
+	}
+	return _c
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (_c *PostCreate) SetSnapshot(v map[string]string) *PostCreate {
+	_c.mutation.SetSnapshot(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PostCreate) SetCreatedAt(v time.Time) *PostCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -122,6 +201,51 @@ func (_c *PostCreate) SetID(v string) *PostCreate {
 	return _c
 }
 
+// AddDeliveryIDs adds the "deliveries" edge to the Delivery entity by IDs.
+func (_c *PostCreate) AddDeliveryIDs(ids ...int) *PostCreate {
+	_c.mutation.AddDeliveryIDs(ids...)
+	return _c
+}
+
+// AddDeliveries adds the "deliveries" edges to the Delivery entity.
+func (_c *PostCreate) AddDeliveries(v ...*Delivery) *PostCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeliveryIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_c *PostCreate) AddRevisionIDs(ids ...int) *PostCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_c *PostCreate) AddRevisions(v ...*PostRevision) *PostCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SavedPost entity by IDs.
+func (_c *PostCreate) AddSafeIDs(ids ...int) *PostCreate {
+	_c.mutation.AddSafeIDs(ids...)
+	return _c
+}
+
+// AddSaves adds the "saves" edges to the SavedPost entity.
+func (_c *PostCreate) AddSaves(v ...*SavedPost) *PostCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSafeIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_c *PostCreate) Mutation() *PostMutation {
 	return _c.mutation
@@ -157,6 +281,26 @@ func (_c *PostCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PostCreate) defaults() {
+	if _, ok := _c.mutation.Content(); !ok {
+		v := post.DefaultContent
+		_c.mutation.SetContent(v)
+	}
+	if _, ok := _c.mutation.ContentMarkdown(); !ok {
+		v := post.DefaultContentMarkdown
+		_c.mutation.SetContentMarkdown(v)
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := post.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.TypeColor(); !ok {
+		v := post.DefaultTypeColor
+		_c.mutation.SetTypeColor(v)
+	}
+	if _, ok := _c.mutation.ExtraText(); !ok {
+		v := post.DefaultExtraText
+		_c.mutation.SetExtraText(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := post.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -188,6 +332,12 @@ func (_c *PostCreate) check() error {
 	if _, ok := _c.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Post.description"`)}
 	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Post.content"`)}
+	}
+	if _, ok := _c.mutation.ContentMarkdown(); !ok {
+		return &ValidationError{Name: "content_markdown", err: errors.New(`ent: missing required field "Post.content_markdown"`)}
+	}
 	if _, ok := _c.mutation.Tags(); !ok {
 		return &ValidationError{Name: "tags", err: errors.New(`ent: missing required field "Post.tags"`)}
 	}
@@ -206,6 +356,15 @@ func (_c *PostCreate) check() error {
 	if _, ok := _c.mutation.Extra(); !ok {
 		return &ValidationError{Name: "extra", err: errors.New(`ent: missing required field "Post.extra"`)}
 	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Post.type"`)}
+	}
+	if _, ok := _c.mutation.TypeColor(); !ok {
+		return &ValidationError{Name: "type_color", err: errors.New(`ent: missing required field "Post.type_color"`)}
+	}
+	if _, ok := _c.mutation.ExtraText(); !ok {
+		return &ValidationError{Name: "extra_text", err: errors.New(`ent: missing required field "Post.extra_text"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Post.created_at"`)}
 	}
@@ -268,6 +427,14 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(post.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.ContentMarkdown(); ok {
+		_spec.SetField(post.FieldContentMarkdown, field.TypeString, value)
+		_node.ContentMarkdown = value
+	}
 	if value, ok := _c.mutation.Tags(); ok {
 		_spec.SetField(post.FieldTags, field.TypeJSON, value)
 		_node.Tags = value
@@ -292,6 +459,22 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldExtra, field.TypeJSON, value)
 		_node.Extra = value
 	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(post.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.TypeColor(); ok {
+		_spec.SetField(post.FieldTypeColor, field.TypeString, value)
+		_node.TypeColor = value
+	}
+	if value, ok := _c.mutation.ExtraText(); ok {
+		_spec.SetField(post.FieldExtraText, field.TypeString, value)
+		_node.ExtraText = value
+	}
+	if value, ok := _c.mutation.Snapshot(); ok {
+		_spec.SetField(post.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -300,6 +483,54 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if nodes := _c.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DeliveriesTable,
+			Columns: []string{post.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SavesTable,
+			Columns: []string{post.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
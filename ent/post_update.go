@@ -12,8 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/delivery"
 	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/postrevision"
 	"github.com/wintbiit/rmtv/ent/predicate"
+	"github.com/wintbiit/rmtv/ent/savedpost"
 )
 
 // PostUpdate is the builder for updating Post entities.
@@ -91,6 +94,34 @@ func (_u *PostUpdate) SetNillableDescription(v *string) *PostUpdate {
 	return _u
 }
 
+// SetContent sets the "content" field.
+func (_u *PostUpdate) SetContent(v string) *PostUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableContent(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetContentMarkdown sets the "content_markdown" field.
+func (_u *PostUpdate) SetContentMarkdown(v string) *PostUpdate {
+	_u.mutation.SetContentMarkdown(v)
+	return _u
+}
+
+// SetNillableContentMarkdown sets the "content_markdown" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableContentMarkdown(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetContentMarkdown(*v)
+	}
+	return _u
+}
+
 // SetTags sets the "tags" field.
 func (_u *PostUpdate) SetTags(v []string) *PostUpdate {
 	_u.mutation.SetTags(v)
@@ -165,6 +196,60 @@ func (_u *PostUpdate) SetExtra(v any) *PostUpdate {
 	return _u
 }
 
+// SetType sets the "type" field.
+func (_u *PostUpdate) SetType(v string) *PostUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableType(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetTypeColor sets the "type_color" field.
+func (_u *PostUpdate) SetTypeColor(v string) *PostUpdate {
+	_u.mutation.SetTypeColor(v)
+	return _u
+}
+
+// SetNillableTypeColor sets the "type_color" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableTypeColor(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetTypeColor(*v)
+	}
+	return _u
+}
+
+// SetExtraText sets the "extra_text" field.
+func (_u *PostUpdate) SetExtraText(v string) *PostUpdate {
+	_u.mutation.SetExtraText(v)
+	return _u
+}
+
+// SetNillableExtraText sets the "extra_text" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableExtraText(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetExtraText(*v)
+	}
+	return _u
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (_u *PostUpdate) SetSnapshot(v map[string]string) *PostUpdate {
+	_u.mutation.SetSnapshot(v)
+	return _u
+}
+
+// ClearSnapshot clears the value of the "snapshot" field.
+func (_u *PostUpdate) ClearSnapshot() *PostUpdate {
+	_u.mutation.ClearSnapshot()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *PostUpdate) SetCreatedAt(v time.Time) *PostUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -193,11 +278,119 @@ func (_u *PostUpdate) SetNillableUpdatedAt(v *time.Time) *PostUpdate {
 	return _u
 }
 
+// AddDeliveryIDs adds the "deliveries" edge to the Delivery entity by IDs.
+func (_u *PostUpdate) AddDeliveryIDs(ids ...int) *PostUpdate {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the Delivery entity.
+func (_u *PostUpdate) AddDeliveries(v ...*Delivery) *PostUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_u *PostUpdate) AddRevisionIDs(ids ...int) *PostUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_u *PostUpdate) AddRevisions(v ...*PostRevision) *PostUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SavedPost entity by IDs.
+func (_u *PostUpdate) AddSafeIDs(ids ...int) *PostUpdate {
+	_u.mutation.AddSafeIDs(ids...)
+	return _u
+}
+
+// AddSaves adds the "saves" edges to the SavedPost entity.
+func (_u *PostUpdate) AddSaves(v ...*SavedPost) *PostUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSafeIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_u *PostUpdate) Mutation() *PostMutation {
 	return _u.mutation
 }
 
+// ClearDeliveries clears all "deliveries" edges to the Delivery entity.
+func (_u *PostUpdate) ClearDeliveries() *PostUpdate {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to Delivery entities by IDs.
+func (_u *PostUpdate) RemoveDeliveryIDs(ids ...int) *PostUpdate {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to Delivery entities.
+func (_u *PostUpdate) RemoveDeliveries(v ...*Delivery) *PostUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (_u *PostUpdate) ClearRevisions() *PostUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (_u *PostUpdate) RemoveRevisionIDs(ids ...int) *PostUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (_u *PostUpdate) RemoveRevisions(v ...*PostRevision) *PostUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearSaves clears all "saves" edges to the SavedPost entity.
+func (_u *PostUpdate) ClearSaves() *PostUpdate {
+	_u.mutation.ClearSaves()
+	return _u
+}
+
+// RemoveSafeIDs removes the "saves" edge to SavedPost entities by IDs.
+func (_u *PostUpdate) RemoveSafeIDs(ids ...int) *PostUpdate {
+	_u.mutation.RemoveSafeIDs(ids...)
+	return _u
+}
+
+// RemoveSaves removes "saves" edges to SavedPost entities.
+func (_u *PostUpdate) RemoveSaves(v ...*SavedPost) *PostUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSafeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -267,6 +460,12 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(post.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(post.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentMarkdown(); ok {
+		_spec.SetField(post.FieldContentMarkdown, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Tags(); ok {
 		_spec.SetField(post.FieldTags, field.TypeJSON, value)
 	}
@@ -290,12 +489,162 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Extra(); ok {
 		_spec.SetField(post.FieldExtra, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(post.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TypeColor(); ok {
+		_spec.SetField(post.FieldTypeColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExtraText(); ok {
+		_spec.SetField(post.FieldExtraText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Snapshot(); ok {
+		_spec.SetField(post.FieldSnapshot, field.TypeJSON, value)
+	}
+	if _u.mutation.SnapshotCleared() {
+		_spec.ClearField(post.FieldSnapshot, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(post.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DeliveriesTable,
+			Columns: []string{post.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DeliveriesTable,
+			Columns: []string{post.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DeliveriesTable,
+			Columns: []string{post.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SavesTable,
+			Columns: []string{post.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavesIDs(); len(nodes) > 0 && !_u.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SavesTable,
+			Columns: []string{post.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SavesTable,
+			Columns: []string{post.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{post.Label}
@@ -378,6 +727,34 @@ func (_u *PostUpdateOne) SetNillableDescription(v *string) *PostUpdateOne {
 	return _u
 }
 
+// SetContent sets the "content" field.
+func (_u *PostUpdateOne) SetContent(v string) *PostUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableContent(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetContentMarkdown sets the "content_markdown" field.
+func (_u *PostUpdateOne) SetContentMarkdown(v string) *PostUpdateOne {
+	_u.mutation.SetContentMarkdown(v)
+	return _u
+}
+
+// SetNillableContentMarkdown sets the "content_markdown" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableContentMarkdown(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetContentMarkdown(*v)
+	}
+	return _u
+}
+
 // SetTags sets the "tags" field.
 func (_u *PostUpdateOne) SetTags(v []string) *PostUpdateOne {
 	_u.mutation.SetTags(v)
@@ -452,6 +829,60 @@ func (_u *PostUpdateOne) SetExtra(v any) *PostUpdateOne {
 	return _u
 }
 
+// SetType sets the "type" field.
+func (_u *PostUpdateOne) SetType(v string) *PostUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableType(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetTypeColor sets the "type_color" field.
+func (_u *PostUpdateOne) SetTypeColor(v string) *PostUpdateOne {
+	_u.mutation.SetTypeColor(v)
+	return _u
+}
+
+// SetNillableTypeColor sets the "type_color" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableTypeColor(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetTypeColor(*v)
+	}
+	return _u
+}
+
+// SetExtraText sets the "extra_text" field.
+func (_u *PostUpdateOne) SetExtraText(v string) *PostUpdateOne {
+	_u.mutation.SetExtraText(v)
+	return _u
+}
+
+// SetNillableExtraText sets the "extra_text" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableExtraText(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetExtraText(*v)
+	}
+	return _u
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (_u *PostUpdateOne) SetSnapshot(v map[string]string) *PostUpdateOne {
+	_u.mutation.SetSnapshot(v)
+	return _u
+}
+
+// ClearSnapshot clears the value of the "snapshot" field.
+func (_u *PostUpdateOne) ClearSnapshot() *PostUpdateOne {
+	_u.mutation.ClearSnapshot()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *PostUpdateOne) SetCreatedAt(v time.Time) *PostUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -480,11 +911,119 @@ func (_u *PostUpdateOne) SetNillableUpdatedAt(v *time.Time) *PostUpdateOne {
 	return _u
 }
 
+// AddDeliveryIDs adds the "deliveries" edge to the Delivery entity by IDs.
+func (_u *PostUpdateOne) AddDeliveryIDs(ids ...int) *PostUpdateOne {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the Delivery entity.
+func (_u *PostUpdateOne) AddDeliveries(v ...*Delivery) *PostUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_u *PostUpdateOne) AddRevisionIDs(ids ...int) *PostUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_u *PostUpdateOne) AddRevisions(v ...*PostRevision) *PostUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SavedPost entity by IDs.
+func (_u *PostUpdateOne) AddSafeIDs(ids ...int) *PostUpdateOne {
+	_u.mutation.AddSafeIDs(ids...)
+	return _u
+}
+
+// AddSaves adds the "saves" edges to the SavedPost entity.
+func (_u *PostUpdateOne) AddSaves(v ...*SavedPost) *PostUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSafeIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_u *PostUpdateOne) Mutation() *PostMutation {
 	return _u.mutation
 }
 
+// ClearDeliveries clears all "deliveries" edges to the Delivery entity.
+func (_u *PostUpdateOne) ClearDeliveries() *PostUpdateOne {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to Delivery entities by IDs.
+func (_u *PostUpdateOne) RemoveDeliveryIDs(ids ...int) *PostUpdateOne {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to Delivery entities.
+func (_u *PostUpdateOne) RemoveDeliveries(v ...*Delivery) *PostUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (_u *PostUpdateOne) ClearRevisions() *PostUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (_u *PostUpdateOne) RemoveRevisionIDs(ids ...int) *PostUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (_u *PostUpdateOne) RemoveRevisions(v ...*PostRevision) *PostUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearSaves clears all "saves" edges to the SavedPost entity.
+func (_u *PostUpdateOne) ClearSaves() *PostUpdateOne {
+	_u.mutation.ClearSaves()
+	return _u
+}
+
+// RemoveSafeIDs removes the "saves" edge to SavedPost entities by IDs.
+func (_u *PostUpdateOne) RemoveSafeIDs(ids ...int) *PostUpdateOne {
+	_u.mutation.RemoveSafeIDs(ids...)
+	return _u
+}
+
+// RemoveSaves removes "saves" edges to SavedPost entities.
+func (_u *PostUpdateOne) RemoveSaves(v ...*SavedPost) *PostUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSafeIDs(ids...)
+}
+
 // Where appends a list predicates to the PostUpdate builder.
 func (_u *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
 	_u.mutation.Where(ps...)
@@ -584,6 +1123,12 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(post.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(post.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentMarkdown(); ok {
+		_spec.SetField(post.FieldContentMarkdown, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Tags(); ok {
 		_spec.SetField(post.FieldTags, field.TypeJSON, value)
 	}
@@ -607,12 +1152,162 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if value, ok := _u.mutation.Extra(); ok {
 		_spec.SetField(post.FieldExtra, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(post.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TypeColor(); ok {
+		_spec.SetField(post.FieldTypeColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExtraText(); ok {
+		_spec.SetField(post.FieldExtraText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Snapshot(); ok {
+		_spec.SetField(post.FieldSnapshot, field.TypeJSON, value)
+	}
+	if _u.mutation.SnapshotCleared() {
+		_spec.ClearField(post.FieldSnapshot, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(post.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DeliveriesTable,
+			Columns: []string{post.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DeliveriesTable,
+			Columns: []string{post.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DeliveriesTable,
+			Columns: []string{post.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(delivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SavesTable,
+			Columns: []string{post.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavesIDs(); len(nodes) > 0 && !_u.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SavesTable,
+			Columns: []string{post.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SavesTable,
+			Columns: []string{post.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Post{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
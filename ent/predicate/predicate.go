@@ -6,5 +6,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Delivery is the predicate function for delivery builders.
+type Delivery func(*sql.Selector)
+
+// ImageCache is the predicate function for imagecache builders.
+type ImageCache func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
+
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// PushedCard is the predicate function for pushedcard builders.
+type PushedCard func(*sql.Selector)
+
+// SavedPost is the predicate function for savedpost builders.
+type SavedPost func(*sql.Selector)
+
+// State is the predicate function for state builders.
+type State func(*sql.Selector)
+
+// Subscription is the predicate function for subscription builders.
+type Subscription func(*sql.Selector)
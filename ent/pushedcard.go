@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wintbiit/rmtv/ent/pushedcard"
+	"github.com/wintbiit/rmtv/ent/schema"
+)
+
+// PushedCard is the model entity for the PushedCard schema.
+type PushedCard struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 消息ID
+	ID string `json:"id,omitempty"`
+	// 群聊ID
+	ChatID string `json:"chat_id,omitempty"`
+	// 卡片上的帖子
+	Posts []schema.CardPost `json:"posts,omitempty"`
+	// 推送时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PushedCard) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pushedcard.FieldPosts:
+			values[i] = new([]byte)
+		case pushedcard.FieldID, pushedcard.FieldChatID:
+			values[i] = new(sql.NullString)
+		case pushedcard.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PushedCard fields.
+func (_m *PushedCard) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pushedcard.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case pushedcard.FieldChatID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.String
+			}
+		case pushedcard.FieldPosts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field posts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Posts); err != nil {
+					return fmt.Errorf("unmarshal field posts: %w", err)
+				}
+			}
+		case pushedcard.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PushedCard.
+// This includes values selected through modifiers, order, etc.
+func (_m *PushedCard) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PushedCard.
+// Note that you need to call PushedCard.Unwrap() before calling this method if this PushedCard
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PushedCard) Update() *PushedCardUpdateOne {
+	return NewPushedCardClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PushedCard entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PushedCard) Unwrap() *PushedCard {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PushedCard is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PushedCard) String() string {
+	var builder strings.Builder
+	builder.WriteString("PushedCard(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("chat_id=")
+	builder.WriteString(_m.ChatID)
+	builder.WriteString(", ")
+	builder.WriteString("posts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Posts))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PushedCards is a parsable slice of PushedCard.
+type PushedCards []*PushedCard
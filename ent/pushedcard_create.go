@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/pushedcard"
+	"github.com/wintbiit/rmtv/ent/schema"
+)
+
+// PushedCardCreate is the builder for creating a PushedCard entity.
+type PushedCardCreate struct {
+	config
+	mutation *PushedCardMutation
+	hooks    []Hook
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *PushedCardCreate) SetChatID(v string) *PushedCardCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetPosts sets the "posts" field.
+func (_c *PushedCardCreate) SetPosts(v []schema.CardPost) *PushedCardCreate {
+	_c.mutation.SetPosts(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PushedCardCreate) SetCreatedAt(v time.Time) *PushedCardCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PushedCardCreate) SetNillableCreatedAt(v *time.Time) *PushedCardCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PushedCardCreate) SetID(v string) *PushedCardCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PushedCardMutation object of the builder.
+func (_c *PushedCardCreate) Mutation() *PushedCardMutation {
+	return _c.mutation
+}
+
+// Save creates the PushedCard in the database.
+func (_c *PushedCardCreate) Save(ctx context.Context) (*PushedCard, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PushedCardCreate) SaveX(ctx context.Context) *PushedCard {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PushedCardCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PushedCardCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PushedCardCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := pushedcard.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PushedCardCreate) check() error {
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "PushedCard.chat_id"`)}
+	}
+	if v, ok := _c.mutation.ChatID(); ok {
+		if err := pushedcard.ChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`ent: validator failed for field "PushedCard.chat_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Posts(); !ok {
+		return &ValidationError{Name: "posts", err: errors.New(`ent: missing required field "PushedCard.posts"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PushedCard.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := pushedcard.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PushedCard.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PushedCardCreate) sqlSave(ctx context.Context) (*PushedCard, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PushedCard.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PushedCardCreate) createSpec() (*PushedCard, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PushedCard{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pushedcard.Table, sqlgraph.NewFieldSpec(pushedcard.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(pushedcard.FieldChatID, field.TypeString, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.Posts(); ok {
+		_spec.SetField(pushedcard.FieldPosts, field.TypeJSON, value)
+		_node.Posts = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(pushedcard.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PushedCardCreateBulk is the builder for creating many PushedCard entities in bulk.
+type PushedCardCreateBulk struct {
+	config
+	err      error
+	builders []*PushedCardCreate
+}
+
+// Save creates the PushedCard entities in the database.
+func (_c *PushedCardCreateBulk) Save(ctx context.Context) ([]*PushedCard, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PushedCard, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PushedCardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PushedCardCreateBulk) SaveX(ctx context.Context) []*PushedCard {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PushedCardCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PushedCardCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/predicate"
+	"github.com/wintbiit/rmtv/ent/pushedcard"
+	"github.com/wintbiit/rmtv/ent/schema"
+)
+
+// PushedCardUpdate is the builder for updating PushedCard entities.
+type PushedCardUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PushedCardMutation
+}
+
+// Where appends a list predicates to the PushedCardUpdate builder.
+func (_u *PushedCardUpdate) Where(ps ...predicate.PushedCard) *PushedCardUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *PushedCardUpdate) SetChatID(v string) *PushedCardUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *PushedCardUpdate) SetNillableChatID(v *string) *PushedCardUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetPosts sets the "posts" field.
+func (_u *PushedCardUpdate) SetPosts(v []schema.CardPost) *PushedCardUpdate {
+	_u.mutation.SetPosts(v)
+	return _u
+}
+
+// AppendPosts appends value to the "posts" field.
+func (_u *PushedCardUpdate) AppendPosts(v []schema.CardPost) *PushedCardUpdate {
+	_u.mutation.AppendPosts(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PushedCardUpdate) SetCreatedAt(v time.Time) *PushedCardUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PushedCardUpdate) SetNillableCreatedAt(v *time.Time) *PushedCardUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PushedCardMutation object of the builder.
+func (_u *PushedCardUpdate) Mutation() *PushedCardMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PushedCardUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PushedCardUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PushedCardUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PushedCardUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PushedCardUpdate) check() error {
+	if v, ok := _u.mutation.ChatID(); ok {
+		if err := pushedcard.ChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`ent: validator failed for field "PushedCard.chat_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PushedCardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pushedcard.Table, pushedcard.Columns, sqlgraph.NewFieldSpec(pushedcard.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(pushedcard.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Posts(); ok {
+		_spec.SetField(pushedcard.FieldPosts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPosts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pushedcard.FieldPosts, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pushedcard.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pushedcard.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PushedCardUpdateOne is the builder for updating a single PushedCard entity.
+type PushedCardUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PushedCardMutation
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *PushedCardUpdateOne) SetChatID(v string) *PushedCardUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *PushedCardUpdateOne) SetNillableChatID(v *string) *PushedCardUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetPosts sets the "posts" field.
+func (_u *PushedCardUpdateOne) SetPosts(v []schema.CardPost) *PushedCardUpdateOne {
+	_u.mutation.SetPosts(v)
+	return _u
+}
+
+// AppendPosts appends value to the "posts" field.
+func (_u *PushedCardUpdateOne) AppendPosts(v []schema.CardPost) *PushedCardUpdateOne {
+	_u.mutation.AppendPosts(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PushedCardUpdateOne) SetCreatedAt(v time.Time) *PushedCardUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PushedCardUpdateOne) SetNillableCreatedAt(v *time.Time) *PushedCardUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PushedCardMutation object of the builder.
+func (_u *PushedCardUpdateOne) Mutation() *PushedCardMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PushedCardUpdate builder.
+func (_u *PushedCardUpdateOne) Where(ps ...predicate.PushedCard) *PushedCardUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PushedCardUpdateOne) Select(field string, fields ...string) *PushedCardUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PushedCard entity.
+func (_u *PushedCardUpdateOne) Save(ctx context.Context) (*PushedCard, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PushedCardUpdateOne) SaveX(ctx context.Context) *PushedCard {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PushedCardUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PushedCardUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PushedCardUpdateOne) check() error {
+	if v, ok := _u.mutation.ChatID(); ok {
+		if err := pushedcard.ChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`ent: validator failed for field "PushedCard.chat_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PushedCardUpdateOne) sqlSave(ctx context.Context) (_node *PushedCard, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pushedcard.Table, pushedcard.Columns, sqlgraph.NewFieldSpec(pushedcard.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PushedCard.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pushedcard.FieldID)
+		for _, f := range fields {
+			if !pushedcard.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pushedcard.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(pushedcard.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Posts(); ok {
+		_spec.SetField(pushedcard.FieldPosts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPosts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pushedcard.FieldPosts, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pushedcard.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &PushedCard{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pushedcard.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
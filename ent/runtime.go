@@ -5,14 +5,79 @@ package ent
 import (
 	"time"
 
+	"github.com/wintbiit/rmtv/ent/delivery"
+	"github.com/wintbiit/rmtv/ent/imagecache"
 	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/postrevision"
+	"github.com/wintbiit/rmtv/ent/pushedcard"
+	"github.com/wintbiit/rmtv/ent/savedpost"
 	"github.com/wintbiit/rmtv/ent/schema"
+	"github.com/wintbiit/rmtv/ent/state"
+	"github.com/wintbiit/rmtv/ent/subscription"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	deliveryFields := schema.Delivery{}.Fields()
+	_ = deliveryFields
+	// deliveryDescPostID is the schema descriptor for post_id field.
+	deliveryDescPostID := deliveryFields[0].Descriptor()
+	// delivery.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	delivery.PostIDValidator = deliveryDescPostID.Validators[0].(func(string) error)
+	// deliveryDescConsumer is the schema descriptor for consumer field.
+	deliveryDescConsumer := deliveryFields[2].Descriptor()
+	// delivery.ConsumerValidator is a validator for the "consumer" field. It is called by the builders before save.
+	delivery.ConsumerValidator = deliveryDescConsumer.Validators[0].(func(string) error)
+	// deliveryDescTarget is the schema descriptor for target field.
+	deliveryDescTarget := deliveryFields[3].Descriptor()
+	// delivery.DefaultTarget holds the default value on creation for the target field.
+	delivery.DefaultTarget = deliveryDescTarget.Default.(string)
+	// deliveryDescAttempts is the schema descriptor for attempts field.
+	deliveryDescAttempts := deliveryFields[5].Descriptor()
+	// delivery.DefaultAttempts holds the default value on creation for the attempts field.
+	delivery.DefaultAttempts = deliveryDescAttempts.Default.(int)
+	// deliveryDescNextRetryAt is the schema descriptor for next_retry_at field.
+	deliveryDescNextRetryAt := deliveryFields[7].Descriptor()
+	// delivery.DefaultNextRetryAt holds the default value on creation for the next_retry_at field.
+	delivery.DefaultNextRetryAt = deliveryDescNextRetryAt.Default.(func() time.Time)
+	// deliveryDescCreatedAt is the schema descriptor for created_at field.
+	deliveryDescCreatedAt := deliveryFields[8].Descriptor()
+	// delivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	delivery.DefaultCreatedAt = deliveryDescCreatedAt.Default.(func() time.Time)
+	// deliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	deliveryDescUpdatedAt := deliveryFields[9].Descriptor()
+	// delivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	delivery.DefaultUpdatedAt = deliveryDescUpdatedAt.Default.(func() time.Time)
+	// delivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	delivery.UpdateDefaultUpdatedAt = deliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	imagecacheFields := schema.ImageCache{}.Fields()
+	_ = imagecacheFields
+	// imagecacheDescAppID is the schema descriptor for app_id field.
+	imagecacheDescAppID := imagecacheFields[1].Descriptor()
+	// imagecache.AppIDValidator is a validator for the "app_id" field. It is called by the builders before save.
+	imagecache.AppIDValidator = imagecacheDescAppID.Validators[0].(func(string) error)
+	// imagecacheDescURL is the schema descriptor for url field.
+	imagecacheDescURL := imagecacheFields[2].Descriptor()
+	// imagecache.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	imagecache.URLValidator = imagecacheDescURL.Validators[0].(func(string) error)
+	// imagecacheDescContentHash is the schema descriptor for content_hash field.
+	imagecacheDescContentHash := imagecacheFields[3].Descriptor()
+	// imagecache.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	imagecache.ContentHashValidator = imagecacheDescContentHash.Validators[0].(func(string) error)
+	// imagecacheDescImageKey is the schema descriptor for image_key field.
+	imagecacheDescImageKey := imagecacheFields[4].Descriptor()
+	// imagecache.ImageKeyValidator is a validator for the "image_key" field. It is called by the builders before save.
+	imagecache.ImageKeyValidator = imagecacheDescImageKey.Validators[0].(func(string) error)
+	// imagecacheDescCreatedAt is the schema descriptor for created_at field.
+	imagecacheDescCreatedAt := imagecacheFields[5].Descriptor()
+	// imagecache.DefaultCreatedAt holds the default value on creation for the created_at field.
+	imagecache.DefaultCreatedAt = imagecacheDescCreatedAt.Default.(func() time.Time)
+	// imagecacheDescID is the schema descriptor for id field.
+	imagecacheDescID := imagecacheFields[0].Descriptor()
+	// imagecache.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	imagecache.IDValidator = imagecacheDescID.Validators[0].(func(string) error)
 	postFields := schema.Post{}.Fields()
 	_ = postFields
 	// postDescSource is the schema descriptor for source field.
@@ -23,16 +88,122 @@ func init() {
 	postDescTitle := postFields[3].Descriptor()
 	// post.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	post.TitleValidator = postDescTitle.Validators[0].(func(string) error)
+	// postDescContent is the schema descriptor for content field.
+	postDescContent := postFields[5].Descriptor()
+	// post.DefaultContent holds the default value on creation for the content field.
+	post.DefaultContent = postDescContent.Default.(string)
+	// postDescContentMarkdown is the schema descriptor for content_markdown field.
+	postDescContentMarkdown := postFields[6].Descriptor()
+	// post.DefaultContentMarkdown holds the default value on creation for the content_markdown field.
+	post.DefaultContentMarkdown = postDescContentMarkdown.Default.(string)
+	// postDescType is the schema descriptor for type field.
+	postDescType := postFields[13].Descriptor()
+	// post.DefaultType holds the default value on creation for the type field.
+	post.DefaultType = postDescType.Default.(string)
+	// postDescTypeColor is the schema descriptor for type_color field.
+	postDescTypeColor := postFields[14].Descriptor()
+	// post.DefaultTypeColor holds the default value on creation for the type_color field.
+	post.DefaultTypeColor = postDescTypeColor.Default.(string)
+	// postDescExtraText is the schema descriptor for extra_text field.
+	postDescExtraText := postFields[15].Descriptor()
+	// post.DefaultExtraText holds the default value on creation for the extra_text field.
+	post.DefaultExtraText = postDescExtraText.Default.(string)
 	// postDescCreatedAt is the schema descriptor for created_at field.
-	postDescCreatedAt := postFields[11].Descriptor()
+	postDescCreatedAt := postFields[17].Descriptor()
 	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
 	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
 	// postDescUpdatedAt is the schema descriptor for updated_at field.
-	postDescUpdatedAt := postFields[12].Descriptor()
+	postDescUpdatedAt := postFields[18].Descriptor()
 	// post.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	post.DefaultUpdatedAt = postDescUpdatedAt.Default.(func() time.Time)
 	// postDescID is the schema descriptor for id field.
 	postDescID := postFields[1].Descriptor()
 	// post.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	post.IDValidator = postDescID.Validators[0].(func(string) error)
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescPostID is the schema descriptor for post_id field.
+	postrevisionDescPostID := postrevisionFields[0].Descriptor()
+	// postrevision.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	postrevision.PostIDValidator = postrevisionDescPostID.Validators[0].(func(string) error)
+	// postrevisionDescCreatedAt is the schema descriptor for created_at field.
+	postrevisionDescCreatedAt := postrevisionFields[2].Descriptor()
+	// postrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postrevision.DefaultCreatedAt = postrevisionDescCreatedAt.Default.(func() time.Time)
+	pushedcardFields := schema.PushedCard{}.Fields()
+	_ = pushedcardFields
+	// pushedcardDescChatID is the schema descriptor for chat_id field.
+	pushedcardDescChatID := pushedcardFields[1].Descriptor()
+	// pushedcard.ChatIDValidator is a validator for the "chat_id" field. It is called by the builders before save.
+	pushedcard.ChatIDValidator = pushedcardDescChatID.Validators[0].(func(string) error)
+	// pushedcardDescCreatedAt is the schema descriptor for created_at field.
+	pushedcardDescCreatedAt := pushedcardFields[3].Descriptor()
+	// pushedcard.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pushedcard.DefaultCreatedAt = pushedcardDescCreatedAt.Default.(func() time.Time)
+	// pushedcardDescID is the schema descriptor for id field.
+	pushedcardDescID := pushedcardFields[0].Descriptor()
+	// pushedcard.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	pushedcard.IDValidator = pushedcardDescID.Validators[0].(func(string) error)
+	savedpostFields := schema.SavedPost{}.Fields()
+	_ = savedpostFields
+	// savedpostDescChatID is the schema descriptor for chat_id field.
+	savedpostDescChatID := savedpostFields[0].Descriptor()
+	// savedpost.ChatIDValidator is a validator for the "chat_id" field. It is called by the builders before save.
+	savedpost.ChatIDValidator = savedpostDescChatID.Validators[0].(func(string) error)
+	// savedpostDescPostID is the schema descriptor for post_id field.
+	savedpostDescPostID := savedpostFields[1].Descriptor()
+	// savedpost.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	savedpost.PostIDValidator = savedpostDescPostID.Validators[0].(func(string) error)
+	// savedpostDescCreatedAt is the schema descriptor for created_at field.
+	savedpostDescCreatedAt := savedpostFields[3].Descriptor()
+	// savedpost.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedpost.DefaultCreatedAt = savedpostDescCreatedAt.Default.(func() time.Time)
+	stateFields := schema.State{}.Fields()
+	_ = stateFields
+	// stateDescUpdatedAt is the schema descriptor for updated_at field.
+	stateDescUpdatedAt := stateFields[2].Descriptor()
+	// state.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	state.DefaultUpdatedAt = stateDescUpdatedAt.Default.(func() time.Time)
+	// state.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	state.UpdateDefaultUpdatedAt = stateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// stateDescID is the schema descriptor for id field.
+	stateDescID := stateFields[0].Descriptor()
+	// state.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	state.IDValidator = stateDescID.Validators[0].(func(string) error)
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescSources is the schema descriptor for sources field.
+	subscriptionDescSources := subscriptionFields[1].Descriptor()
+	// subscription.DefaultSources holds the default value on creation for the sources field.
+	subscription.DefaultSources = subscriptionDescSources.Default.([]string)
+	// subscriptionDescMutedSources is the schema descriptor for muted_sources field.
+	subscriptionDescMutedSources := subscriptionFields[2].Descriptor()
+	// subscription.DefaultMutedSources holds the default value on creation for the muted_sources field.
+	subscription.DefaultMutedSources = subscriptionDescMutedSources.Default.([]string)
+	// subscriptionDescKeywords is the schema descriptor for keywords field.
+	subscriptionDescKeywords := subscriptionFields[3].Descriptor()
+	// subscription.DefaultKeywords holds the default value on creation for the keywords field.
+	subscription.DefaultKeywords = subscriptionDescKeywords.Default.([]string)
+	// subscriptionDescMutedAuthors is the schema descriptor for muted_authors field.
+	subscriptionDescMutedAuthors := subscriptionFields[4].Descriptor()
+	// subscription.DefaultMutedAuthors holds the default value on creation for the muted_authors field.
+	subscription.DefaultMutedAuthors = subscriptionDescMutedAuthors.Default.([]string)
+	// subscriptionDescMutedTags is the schema descriptor for muted_tags field.
+	subscriptionDescMutedTags := subscriptionFields[5].Descriptor()
+	// subscription.DefaultMutedTags holds the default value on creation for the muted_tags field.
+	subscription.DefaultMutedTags = subscriptionDescMutedTags.Default.([]string)
+	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionDescCreatedAt := subscriptionFields[6].Descriptor()
+	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
+	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionDescUpdatedAt := subscriptionFields[7].Descriptor()
+	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
+	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscription.UpdateDefaultUpdatedAt = subscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionDescID is the schema descriptor for id field.
+	subscriptionDescID := subscriptionFields[0].Descriptor()
+	// subscription.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	subscription.IDValidator = subscriptionDescID.Validators[0].(func(string) error)
 }
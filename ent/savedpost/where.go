@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedpost
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/wintbiit/rmtv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLTE(FieldID, id))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldChatID, v))
+}
+
+// PostID applies equality check predicate on the "post_id" field. It's identical to PostIDEQ.
+func PostID(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldPostID, v))
+}
+
+// SavedBy applies equality check predicate on the "saved_by" field. It's identical to SavedByEQ.
+func SavedBy(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldSavedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLTE(FieldChatID, v))
+}
+
+// ChatIDContains applies the Contains predicate on the "chat_id" field.
+func ChatIDContains(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldContains(FieldChatID, v))
+}
+
+// ChatIDHasPrefix applies the HasPrefix predicate on the "chat_id" field.
+func ChatIDHasPrefix(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldHasPrefix(FieldChatID, v))
+}
+
+// ChatIDHasSuffix applies the HasSuffix predicate on the "chat_id" field.
+func ChatIDHasSuffix(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldHasSuffix(FieldChatID, v))
+}
+
+// ChatIDEqualFold applies the EqualFold predicate on the "chat_id" field.
+func ChatIDEqualFold(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEqualFold(FieldChatID, v))
+}
+
+// ChatIDContainsFold applies the ContainsFold predicate on the "chat_id" field.
+func ChatIDContainsFold(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldContainsFold(FieldChatID, v))
+}
+
+// PostIDEQ applies the EQ predicate on the "post_id" field.
+func PostIDEQ(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldPostID, v))
+}
+
+// PostIDNEQ applies the NEQ predicate on the "post_id" field.
+func PostIDNEQ(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNEQ(FieldPostID, v))
+}
+
+// PostIDIn applies the In predicate on the "post_id" field.
+func PostIDIn(vs ...string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldIn(FieldPostID, vs...))
+}
+
+// PostIDNotIn applies the NotIn predicate on the "post_id" field.
+func PostIDNotIn(vs ...string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNotIn(FieldPostID, vs...))
+}
+
+// PostIDGT applies the GT predicate on the "post_id" field.
+func PostIDGT(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGT(FieldPostID, v))
+}
+
+// PostIDGTE applies the GTE predicate on the "post_id" field.
+func PostIDGTE(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGTE(FieldPostID, v))
+}
+
+// PostIDLT applies the LT predicate on the "post_id" field.
+func PostIDLT(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLT(FieldPostID, v))
+}
+
+// PostIDLTE applies the LTE predicate on the "post_id" field.
+func PostIDLTE(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLTE(FieldPostID, v))
+}
+
+// PostIDContains applies the Contains predicate on the "post_id" field.
+func PostIDContains(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldContains(FieldPostID, v))
+}
+
+// PostIDHasPrefix applies the HasPrefix predicate on the "post_id" field.
+func PostIDHasPrefix(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldHasPrefix(FieldPostID, v))
+}
+
+// PostIDHasSuffix applies the HasSuffix predicate on the "post_id" field.
+func PostIDHasSuffix(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldHasSuffix(FieldPostID, v))
+}
+
+// PostIDEqualFold applies the EqualFold predicate on the "post_id" field.
+func PostIDEqualFold(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEqualFold(FieldPostID, v))
+}
+
+// PostIDContainsFold applies the ContainsFold predicate on the "post_id" field.
+func PostIDContainsFold(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldContainsFold(FieldPostID, v))
+}
+
+// SavedByEQ applies the EQ predicate on the "saved_by" field.
+func SavedByEQ(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldSavedBy, v))
+}
+
+// SavedByNEQ applies the NEQ predicate on the "saved_by" field.
+func SavedByNEQ(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNEQ(FieldSavedBy, v))
+}
+
+// SavedByIn applies the In predicate on the "saved_by" field.
+func SavedByIn(vs ...string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldIn(FieldSavedBy, vs...))
+}
+
+// SavedByNotIn applies the NotIn predicate on the "saved_by" field.
+func SavedByNotIn(vs ...string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNotIn(FieldSavedBy, vs...))
+}
+
+// SavedByGT applies the GT predicate on the "saved_by" field.
+func SavedByGT(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGT(FieldSavedBy, v))
+}
+
+// SavedByGTE applies the GTE predicate on the "saved_by" field.
+func SavedByGTE(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGTE(FieldSavedBy, v))
+}
+
+// SavedByLT applies the LT predicate on the "saved_by" field.
+func SavedByLT(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLT(FieldSavedBy, v))
+}
+
+// SavedByLTE applies the LTE predicate on the "saved_by" field.
+func SavedByLTE(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLTE(FieldSavedBy, v))
+}
+
+// SavedByContains applies the Contains predicate on the "saved_by" field.
+func SavedByContains(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldContains(FieldSavedBy, v))
+}
+
+// SavedByHasPrefix applies the HasPrefix predicate on the "saved_by" field.
+func SavedByHasPrefix(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldHasPrefix(FieldSavedBy, v))
+}
+
+// SavedByHasSuffix applies the HasSuffix predicate on the "saved_by" field.
+func SavedByHasSuffix(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldHasSuffix(FieldSavedBy, v))
+}
+
+// SavedByIsNil applies the IsNil predicate on the "saved_by" field.
+func SavedByIsNil() predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldIsNull(FieldSavedBy))
+}
+
+// SavedByNotNil applies the NotNil predicate on the "saved_by" field.
+func SavedByNotNil() predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNotNull(FieldSavedBy))
+}
+
+// SavedByEqualFold applies the EqualFold predicate on the "saved_by" field.
+func SavedByEqualFold(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEqualFold(FieldSavedBy, v))
+}
+
+// SavedByContainsFold applies the ContainsFold predicate on the "saved_by" field.
+func SavedByContainsFold(v string) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldContainsFold(FieldSavedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SavedPost {
+	return predicate.SavedPost(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.SavedPost {
+	return predicate.SavedPost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.SavedPost {
+	return predicate.SavedPost(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedPost) predicate.SavedPost {
+	return predicate.SavedPost(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedPost) predicate.SavedPost {
+	return predicate.SavedPost(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedPost) predicate.SavedPost {
+	return predicate.SavedPost(sql.NotPredicates(p))
+}
@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/predicate"
+	"github.com/wintbiit/rmtv/ent/savedpost"
+)
+
+// SavedPostUpdate is the builder for updating SavedPost entities.
+type SavedPostUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedPostMutation
+}
+
+// Where appends a list predicates to the SavedPostUpdate builder.
+func (_u *SavedPostUpdate) Where(ps ...predicate.SavedPost) *SavedPostUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *SavedPostUpdate) SetChatID(v string) *SavedPostUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *SavedPostUpdate) SetNillableChatID(v *string) *SavedPostUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *SavedPostUpdate) SetPostID(v string) *SavedPostUpdate {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *SavedPostUpdate) SetNillablePostID(v *string) *SavedPostUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetSavedBy sets the "saved_by" field.
+func (_u *SavedPostUpdate) SetSavedBy(v string) *SavedPostUpdate {
+	_u.mutation.SetSavedBy(v)
+	return _u
+}
+
+// SetNillableSavedBy sets the "saved_by" field if the given value is not nil.
+func (_u *SavedPostUpdate) SetNillableSavedBy(v *string) *SavedPostUpdate {
+	if v != nil {
+		_u.SetSavedBy(*v)
+	}
+	return _u
+}
+
+// ClearSavedBy clears the value of the "saved_by" field.
+func (_u *SavedPostUpdate) ClearSavedBy() *SavedPostUpdate {
+	_u.mutation.ClearSavedBy()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SavedPostUpdate) SetCreatedAt(v time.Time) *SavedPostUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SavedPostUpdate) SetNillableCreatedAt(v *time.Time) *SavedPostUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *SavedPostUpdate) SetPost(v *Post) *SavedPostUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the SavedPostMutation object of the builder.
+func (_u *SavedPostUpdate) Mutation() *SavedPostMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *SavedPostUpdate) ClearPost() *SavedPostUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SavedPostUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedPostUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SavedPostUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedPostUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedPostUpdate) check() error {
+	if v, ok := _u.mutation.ChatID(); ok {
+		if err := savedpost.ChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`ent: validator failed for field "SavedPost.chat_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := savedpost.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "SavedPost.post_id": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedPost.post"`)
+	}
+	return nil
+}
+
+func (_u *SavedPostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedpost.Table, savedpost.Columns, sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(savedpost.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SavedBy(); ok {
+		_spec.SetField(savedpost.FieldSavedBy, field.TypeString, value)
+	}
+	if _u.mutation.SavedByCleared() {
+		_spec.ClearField(savedpost.FieldSavedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(savedpost.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedpost.PostTable,
+			Columns: []string{savedpost.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedpost.PostTable,
+			Columns: []string{savedpost.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedpost.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SavedPostUpdateOne is the builder for updating a single SavedPost entity.
+type SavedPostUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedPostMutation
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *SavedPostUpdateOne) SetChatID(v string) *SavedPostUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *SavedPostUpdateOne) SetNillableChatID(v *string) *SavedPostUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *SavedPostUpdateOne) SetPostID(v string) *SavedPostUpdateOne {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *SavedPostUpdateOne) SetNillablePostID(v *string) *SavedPostUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetSavedBy sets the "saved_by" field.
+func (_u *SavedPostUpdateOne) SetSavedBy(v string) *SavedPostUpdateOne {
+	_u.mutation.SetSavedBy(v)
+	return _u
+}
+
+// SetNillableSavedBy sets the "saved_by" field if the given value is not nil.
+func (_u *SavedPostUpdateOne) SetNillableSavedBy(v *string) *SavedPostUpdateOne {
+	if v != nil {
+		_u.SetSavedBy(*v)
+	}
+	return _u
+}
+
+// ClearSavedBy clears the value of the "saved_by" field.
+func (_u *SavedPostUpdateOne) ClearSavedBy() *SavedPostUpdateOne {
+	_u.mutation.ClearSavedBy()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SavedPostUpdateOne) SetCreatedAt(v time.Time) *SavedPostUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SavedPostUpdateOne) SetNillableCreatedAt(v *time.Time) *SavedPostUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *SavedPostUpdateOne) SetPost(v *Post) *SavedPostUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the SavedPostMutation object of the builder.
+func (_u *SavedPostUpdateOne) Mutation() *SavedPostMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *SavedPostUpdateOne) ClearPost() *SavedPostUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Where appends a list predicates to the SavedPostUpdate builder.
+func (_u *SavedPostUpdateOne) Where(ps ...predicate.SavedPost) *SavedPostUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SavedPostUpdateOne) Select(field string, fields ...string) *SavedPostUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SavedPost entity.
+func (_u *SavedPostUpdateOne) Save(ctx context.Context) (*SavedPost, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedPostUpdateOne) SaveX(ctx context.Context) *SavedPost {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SavedPostUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedPostUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedPostUpdateOne) check() error {
+	if v, ok := _u.mutation.ChatID(); ok {
+		if err := savedpost.ChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`ent: validator failed for field "SavedPost.chat_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := savedpost.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "SavedPost.post_id": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedPost.post"`)
+	}
+	return nil
+}
+
+func (_u *SavedPostUpdateOne) sqlSave(ctx context.Context) (_node *SavedPost, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedpost.Table, savedpost.Columns, sqlgraph.NewFieldSpec(savedpost.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedPost.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedpost.FieldID)
+		for _, f := range fields {
+			if !savedpost.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedpost.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(savedpost.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SavedBy(); ok {
+		_spec.SetField(savedpost.FieldSavedBy, field.TypeString, value)
+	}
+	if _u.mutation.SavedByCleared() {
+		_spec.ClearField(savedpost.FieldSavedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(savedpost.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedpost.PostTable,
+			Columns: []string{savedpost.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedpost.PostTable,
+			Columns: []string{savedpost.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedPost{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedpost.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
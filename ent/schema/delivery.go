@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Delivery holds the schema definition for the Delivery entity.
+type Delivery struct {
+	ent.Schema
+}
+
+// Fields of the Delivery.
+func (Delivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("post_id").NotEmpty().Comment("帖子ID"),
+		field.Int("revision_id").Optional().Nillable().Comment("更新记录ID"),
+		field.String("consumer").NotEmpty().Comment("消费者"),
+		field.String("target").Default("").Comment("投递目标，如群聊或 Webhook，为空时尚未按目标拆分"),
+		field.Enum("status").Values("pending", "delivered", "failed", "skipped").Default("pending").Comment("投递状态"),
+		field.Int("attempts").Default(0).Comment("尝试次数"),
+		field.String("last_error").Optional().Comment("最近错误"),
+		field.Time("next_retry_at").Default(time.Now).Comment("下次重试时间"),
+		field.Time("created_at").Default(time.Now).Comment("创建时间"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+	}
+}
+
+// Edges of the Delivery.
+func (Delivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).Ref("deliveries").Field("post_id").Unique().Required(),
+		edge.From("revision", PostRevision.Type).Ref("deliveries").Field("revision_id").Unique(),
+	}
+}
+
+func (Delivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("post_id", "consumer", "target", "revision_id").Unique(),
+		// NULL revisions never conflict in the index above, so deliveries of
+		// new posts need their own.
+		index.Fields("post_id", "consumer", "target").
+			Unique().
+			Annotations(entsql.IndexWhere("revision_id IS NULL")),
+		index.Fields("status", "next_retry_at"),
+	}
+}
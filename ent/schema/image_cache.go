@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ImageCache holds the schema definition for the ImageCache entity.
+type ImageCache struct {
+	ent.Schema
+}
+
+// Fields of the ImageCache.
+func (ImageCache) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Comment("应用ID与图片地址的 sha256"),
+		field.String("app_id").NotEmpty().Comment("上传图片的飞书应用ID"),
+		field.Text("url").NotEmpty().Comment("图片地址"),
+		field.String("content_hash").NotEmpty().Comment("图片内容的 sha256"),
+		field.String("image_key").NotEmpty().Comment("飞书 image_key"),
+		field.Time("created_at").Default(time.Now).Comment("上传时间"),
+	}
+}
+
+// Edges of the ImageCache.
+func (ImageCache) Edges() []ent.Edge {
+	return nil
+}
+
+func (ImageCache) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("app_id", "content_hash"),
+		index.Fields("created_at"),
+	}
+}
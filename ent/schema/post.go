@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -21,12 +22,18 @@ func (Post) Fields() []ent.Field {
 		field.String("picture").Optional().Nillable().Comment("图片"),
 		field.String("title").NotEmpty().Comment("标题"),
 		field.String("description").Comment("描述"),
+		field.Text("content").Default("").Comment("正文HTML"),
+		field.Text("content_markdown").Default("").Comment("正文Markdown"),
 		field.Strings("tags").Comment("标签"),
 		field.Time("pub_date").Comment("发布时间"),
 		field.String("author").Comment("作者"),
 		field.String("author_url").Comment("作者链接"),
 		field.String("url").Comment("链接"),
 		field.Any("extra").Comment("额外信息"),
+		field.String("type").Default("").Comment("类型"),
+		field.String("type_color").Default("").Comment("类型颜色"),
+		field.String("extra_text").Default("").Comment("额外信息文本"),
+		field.JSON("snapshot", map[string]string{}).Optional().Comment("跟踪字段快照"),
 		field.Time("created_at").Default(time.Now).Comment("创建时间"),
 		field.Time("updated_at").Default(time.Now).Comment("更新时间"),
 	}
@@ -34,7 +41,11 @@ func (Post) Fields() []ent.Field {
 
 // Edges of the Post.
 func (Post) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("deliveries", Delivery.Type),
+		edge.To("revisions", PostRevision.Type),
+		edge.To("saves", SavedPost.Type),
+	}
 }
 
 func (Post) Indexes() []ent.Index {
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Change is a single tracked field change of a post.
+type Change struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// PostRevision holds the schema definition for the PostRevision entity.
+type PostRevision struct {
+	ent.Schema
+}
+
+// Fields of the PostRevision.
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("post_id").NotEmpty().Comment("帖子ID"),
+		field.JSON("changes", []Change{}).Comment("变更"),
+		field.Time("created_at").Default(time.Now).Comment("创建时间"),
+	}
+}
+
+// Edges of the PostRevision.
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).Ref("revisions").Field("post_id").Unique().Required(),
+		edge.To("deliveries", Delivery.Type),
+	}
+}
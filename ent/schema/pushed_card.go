@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PushedCard holds the schema definition for the PushedCard entity.
+type PushedCard struct {
+	ent.Schema
+}
+
+// Fields of the PushedCard.
+func (PushedCard) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Comment("消息ID"),
+		field.String("chat_id").NotEmpty().Comment("群聊ID"),
+		field.JSON("posts", []CardPost{}).Comment("卡片上的帖子"),
+		field.Time("created_at").Default(time.Now).Comment("推送时间"),
+	}
+}
+
+// CardPost is a post shown on a pushed card, with the revision announced by
+// an update notification.
+type CardPost struct {
+	PostId     string `json:"post_id"`
+	RevisionId int    `json:"revision_id,omitempty"`
+}
+
+// Edges of the PushedCard.
+func (PushedCard) Edges() []ent.Edge {
+	return nil
+}
+
+func (PushedCard) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SavedPost holds the schema definition for the SavedPost entity.
+type SavedPost struct {
+	ent.Schema
+}
+
+// Fields of the SavedPost.
+func (SavedPost) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("chat_id").NotEmpty().Comment("群聊ID"),
+		field.String("post_id").NotEmpty().Comment("帖子ID"),
+		field.String("saved_by").Optional().Comment("收藏者 open_id"),
+		field.Time("created_at").Default(time.Now).Comment("收藏时间"),
+	}
+}
+
+// Edges of the SavedPost.
+func (SavedPost) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).Ref("saves").Field("post_id").Unique().Required(),
+	}
+}
+
+func (SavedPost) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("chat_id", "post_id").Unique(),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// State holds the schema definition for the State entity.
+type State struct {
+	ent.Schema
+}
+
+// Fields of the State.
+func (State) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Comment("键"),
+		field.JSON("value", json.RawMessage{}).Comment("值"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+	}
+}
+
+// Edges of the State.
+func (State) Edges() []ent.Edge {
+	return nil
+}
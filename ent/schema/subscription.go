@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Subscription holds the schema definition for the Subscription entity.
+type Subscription struct {
+	ent.Schema
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Comment("群聊ID"),
+		field.Strings("sources").Default([]string{}).Comment("订阅来源，为空时订阅全部"),
+		field.Strings("muted_sources").Default([]string{}).Comment("屏蔽来源"),
+		field.Strings("keywords").Default([]string{}).Comment("关键词，为空时不过滤"),
+		field.Strings("muted_authors").Default([]string{}).Comment("屏蔽作者"),
+		field.Strings("muted_tags").Default([]string{}).Comment("屏蔽标签"),
+		field.Time("created_at").Default(time.Now).Comment("创建时间"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wintbiit/rmtv/ent/subscription"
+)
+
+// Subscription is the model entity for the Subscription schema.
+type Subscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 群聊ID
+	ID string `json:"id,omitempty"`
+	// 订阅来源，为空时订阅全部
+	Sources []string `json:"sources,omitempty"`
+	// 屏蔽来源
+	MutedSources []string `json:"muted_sources,omitempty"`
+	// 关键词，为空时不过滤
+	Keywords []string `json:"keywords,omitempty"`
+	// 屏蔽作者
+	MutedAuthors []string `json:"muted_authors,omitempty"`
+	// 屏蔽标签
+	MutedTags []string `json:"muted_tags,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Subscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldSources, subscription.FieldMutedSources, subscription.FieldKeywords, subscription.FieldMutedAuthors, subscription.FieldMutedTags:
+			values[i] = new([]byte)
+		case subscription.FieldID:
+			values[i] = new(sql.NullString)
+		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Subscription fields.
+func (_m *Subscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case subscription.FieldSources:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sources", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Sources); err != nil {
+					return fmt.Errorf("unmarshal field sources: %w", err)
+				}
+			}
+		case subscription.FieldMutedSources:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_sources", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MutedSources); err != nil {
+					return fmt.Errorf("unmarshal field muted_sources: %w", err)
+				}
+			}
+		case subscription.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case subscription.FieldMutedAuthors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_authors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MutedAuthors); err != nil {
+					return fmt.Errorf("unmarshal field muted_authors: %w", err)
+				}
+			}
+		case subscription.FieldMutedTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MutedTags); err != nil {
+					return fmt.Errorf("unmarshal field muted_tags: %w", err)
+				}
+			}
+		case subscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case subscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Subscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *Subscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Subscription.
+// Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Subscription) Update() *SubscriptionUpdateOne {
+	return NewSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Subscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Subscription) Unwrap() *Subscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Subscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Subscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("Subscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("sources=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sources))
+	builder.WriteString(", ")
+	builder.WriteString("muted_sources=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MutedSources))
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Keywords))
+	builder.WriteString(", ")
+	builder.WriteString("muted_authors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MutedAuthors))
+	builder.WriteString(", ")
+	builder.WriteString("muted_tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MutedTags))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Subscriptions is a parsable slice of Subscription.
+type Subscriptions []*Subscription
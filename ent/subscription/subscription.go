@@ -0,0 +1,93 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the subscription type in the database.
+	Label = "subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSources holds the string denoting the sources field in the database.
+	FieldSources = "sources"
+	// FieldMutedSources holds the string denoting the muted_sources field in the database.
+	FieldMutedSources = "muted_sources"
+	// FieldKeywords holds the string denoting the keywords field in the database.
+	FieldKeywords = "keywords"
+	// FieldMutedAuthors holds the string denoting the muted_authors field in the database.
+	FieldMutedAuthors = "muted_authors"
+	// FieldMutedTags holds the string denoting the muted_tags field in the database.
+	FieldMutedTags = "muted_tags"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the subscription in the database.
+	Table = "subscriptions"
+)
+
+// Columns holds all SQL columns for subscription fields.
+var Columns = []string{
+	FieldID,
+	FieldSources,
+	FieldMutedSources,
+	FieldKeywords,
+	FieldMutedAuthors,
+	FieldMutedTags,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSources holds the default value on creation for the "sources" field.
+	DefaultSources []string
+	// DefaultMutedSources holds the default value on creation for the "muted_sources" field.
+	DefaultMutedSources []string
+	// DefaultKeywords holds the default value on creation for the "keywords" field.
+	DefaultKeywords []string
+	// DefaultMutedAuthors holds the default value on creation for the "muted_authors" field.
+	DefaultMutedAuthors []string
+	// DefaultMutedTags holds the default value on creation for the "muted_tags" field.
+	DefaultMutedTags []string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Subscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/subscription"
+)
+
+// SubscriptionCreate is the builder for creating a Subscription entity.
+type SubscriptionCreate struct {
+	config
+	mutation *SubscriptionMutation
+	hooks    []Hook
+}
+
+// SetSources sets the "sources" field.
+func (_c *SubscriptionCreate) SetSources(v []string) *SubscriptionCreate {
+	_c.mutation.SetSources(v)
+	return _c
+}
+
+// SetMutedSources sets the "muted_sources" field.
+func (_c *SubscriptionCreate) SetMutedSources(v []string) *SubscriptionCreate {
+	_c.mutation.SetMutedSources(v)
+	return _c
+}
+
+// SetKeywords sets the "keywords" field.
+func (_c *SubscriptionCreate) SetKeywords(v []string) *SubscriptionCreate {
+	_c.mutation.SetKeywords(v)
+	return _c
+}
+
+// SetMutedAuthors sets the "muted_authors" field.
+func (_c *SubscriptionCreate) SetMutedAuthors(v []string) *SubscriptionCreate {
+	_c.mutation.SetMutedAuthors(v)
+	return _c
+}
+
+// SetMutedTags sets the "muted_tags" field.
+func (_c *SubscriptionCreate) SetMutedTags(v []string) *SubscriptionCreate {
+	_c.mutation.SetMutedTags(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SubscriptionCreate) SetCreatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCreatedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SubscriptionCreate) SetUpdatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableUpdatedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SubscriptionCreate) SetID(v string) *SubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_c *SubscriptionCreate) Mutation() *SubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the Subscription in the database.
+func (_c *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubscriptionCreate) SaveX(ctx context.Context) *Subscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.Sources(); !ok {
+		v := subscription.DefaultSources
+		_c.mutation.SetSources(v)
+	}
+	if _, ok := _c.mutation.MutedSources(); !ok {
+		v := subscription.DefaultMutedSources
+		_c.mutation.SetMutedSources(v)
+	}
+	if _, ok := _c.mutation.Keywords(); !ok {
+		v := subscription.DefaultKeywords
+		_c.mutation.SetKeywords(v)
+	}
+	if _, ok := _c.mutation.MutedAuthors(); !ok {
+		v := subscription.DefaultMutedAuthors
+		_c.mutation.SetMutedAuthors(v)
+	}
+	if _, ok := _c.mutation.MutedTags(); !ok {
+		v := subscription.DefaultMutedTags
+		_c.mutation.SetMutedTags(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := subscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := subscription.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubscriptionCreate) check() error {
+	if _, ok := _c.mutation.Sources(); !ok {
+		return &ValidationError{Name: "sources", err: errors.New(`ent: missing required field "Subscription.sources"`)}
+	}
+	if _, ok := _c.mutation.MutedSources(); !ok {
+		return &ValidationError{Name: "muted_sources", err: errors.New(`ent: missing required field "Subscription.muted_sources"`)}
+	}
+	if _, ok := _c.mutation.Keywords(); !ok {
+		return &ValidationError{Name: "keywords", err: errors.New(`ent: missing required field "Subscription.keywords"`)}
+	}
+	if _, ok := _c.mutation.MutedAuthors(); !ok {
+		return &ValidationError{Name: "muted_authors", err: errors.New(`ent: missing required field "Subscription.muted_authors"`)}
+	}
+	if _, ok := _c.mutation.MutedTags(); !ok {
+		return &ValidationError{Name: "muted_tags", err: errors.New(`ent: missing required field "Subscription.muted_tags"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Subscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Subscription.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := subscription.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Subscription.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Subscription.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Subscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Sources(); ok {
+		_spec.SetField(subscription.FieldSources, field.TypeJSON, value)
+		_node.Sources = value
+	}
+	if value, ok := _c.mutation.MutedSources(); ok {
+		_spec.SetField(subscription.FieldMutedSources, field.TypeJSON, value)
+		_node.MutedSources = value
+	}
+	if value, ok := _c.mutation.Keywords(); ok {
+		_spec.SetField(subscription.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := _c.mutation.MutedAuthors(); ok {
+		_spec.SetField(subscription.FieldMutedAuthors, field.TypeJSON, value)
+		_node.MutedAuthors = value
+	}
+	if value, ok := _c.mutation.MutedTags(); ok {
+		_spec.SetField(subscription.FieldMutedTags, field.TypeJSON, value)
+		_node.MutedTags = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
+type SubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionCreate
+}
+
+// Save creates the Subscription entities in the database.
+func (_c *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Subscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
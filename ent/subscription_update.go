@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/wintbiit/rmtv/ent/predicate"
+	"github.com/wintbiit/rmtv/ent/subscription"
+)
+
+// SubscriptionUpdate is the builder for updating Subscription entities.
+type SubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (_u *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSources sets the "sources" field.
+func (_u *SubscriptionUpdate) SetSources(v []string) *SubscriptionUpdate {
+	_u.mutation.SetSources(v)
+	return _u
+}
+
+// AppendSources appends value to the "sources" field.
+func (_u *SubscriptionUpdate) AppendSources(v []string) *SubscriptionUpdate {
+	_u.mutation.AppendSources(v)
+	return _u
+}
+
+// SetMutedSources sets the "muted_sources" field.
+func (_u *SubscriptionUpdate) SetMutedSources(v []string) *SubscriptionUpdate {
+	_u.mutation.SetMutedSources(v)
+	return _u
+}
+
+// AppendMutedSources appends value to the "muted_sources" field.
+func (_u *SubscriptionUpdate) AppendMutedSources(v []string) *SubscriptionUpdate {
+	_u.mutation.AppendMutedSources(v)
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *SubscriptionUpdate) SetKeywords(v []string) *SubscriptionUpdate {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *SubscriptionUpdate) AppendKeywords(v []string) *SubscriptionUpdate {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// SetMutedAuthors sets the "muted_authors" field.
+func (_u *SubscriptionUpdate) SetMutedAuthors(v []string) *SubscriptionUpdate {
+	_u.mutation.SetMutedAuthors(v)
+	return _u
+}
+
+// AppendMutedAuthors appends value to the "muted_authors" field.
+func (_u *SubscriptionUpdate) AppendMutedAuthors(v []string) *SubscriptionUpdate {
+	_u.mutation.AppendMutedAuthors(v)
+	return _u
+}
+
+// SetMutedTags sets the "muted_tags" field.
+func (_u *SubscriptionUpdate) SetMutedTags(v []string) *SubscriptionUpdate {
+	_u.mutation.SetMutedTags(v)
+	return _u
+}
+
+// AppendMutedTags appends value to the "muted_tags" field.
+func (_u *SubscriptionUpdate) AppendMutedTags(v []string) *SubscriptionUpdate {
+	_u.mutation.AppendMutedTags(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SubscriptionUpdate) SetCreatedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCreatedAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionUpdate) SetUpdatedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_u *SubscriptionUpdate) Mutation() *SubscriptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Sources(); ok {
+		_spec.SetField(subscription.FieldSources, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldSources, value)
+		})
+	}
+	if value, ok := _u.mutation.MutedSources(); ok {
+		_spec.SetField(subscription.FieldMutedSources, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMutedSources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldMutedSources, value)
+		})
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(subscription.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldKeywords, value)
+		})
+	}
+	if value, ok := _u.mutation.MutedAuthors(); ok {
+		_spec.SetField(subscription.FieldMutedAuthors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMutedAuthors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldMutedAuthors, value)
+		})
+	}
+	if value, ok := _u.mutation.MutedTags(); ok {
+		_spec.SetField(subscription.FieldMutedTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMutedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldMutedTags, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SubscriptionUpdateOne is the builder for updating a single Subscription entity.
+type SubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// SetSources sets the "sources" field.
+func (_u *SubscriptionUpdateOne) SetSources(v []string) *SubscriptionUpdateOne {
+	_u.mutation.SetSources(v)
+	return _u
+}
+
+// AppendSources appends value to the "sources" field.
+func (_u *SubscriptionUpdateOne) AppendSources(v []string) *SubscriptionUpdateOne {
+	_u.mutation.AppendSources(v)
+	return _u
+}
+
+// SetMutedSources sets the "muted_sources" field.
+func (_u *SubscriptionUpdateOne) SetMutedSources(v []string) *SubscriptionUpdateOne {
+	_u.mutation.SetMutedSources(v)
+	return _u
+}
+
+// AppendMutedSources appends value to the "muted_sources" field.
+func (_u *SubscriptionUpdateOne) AppendMutedSources(v []string) *SubscriptionUpdateOne {
+	_u.mutation.AppendMutedSources(v)
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *SubscriptionUpdateOne) SetKeywords(v []string) *SubscriptionUpdateOne {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *SubscriptionUpdateOne) AppendKeywords(v []string) *SubscriptionUpdateOne {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// SetMutedAuthors sets the "muted_authors" field.
+func (_u *SubscriptionUpdateOne) SetMutedAuthors(v []string) *SubscriptionUpdateOne {
+	_u.mutation.SetMutedAuthors(v)
+	return _u
+}
+
+// AppendMutedAuthors appends value to the "muted_authors" field.
+func (_u *SubscriptionUpdateOne) AppendMutedAuthors(v []string) *SubscriptionUpdateOne {
+	_u.mutation.AppendMutedAuthors(v)
+	return _u
+}
+
+// SetMutedTags sets the "muted_tags" field.
+func (_u *SubscriptionUpdateOne) SetMutedTags(v []string) *SubscriptionUpdateOne {
+	_u.mutation.SetMutedTags(v)
+	return _u
+}
+
+// AppendMutedTags appends value to the "muted_tags" field.
+func (_u *SubscriptionUpdateOne) AppendMutedTags(v []string) *SubscriptionUpdateOne {
+	_u.mutation.AppendMutedTags(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SubscriptionUpdateOne) SetCreatedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCreatedAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionUpdateOne) SetUpdatedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_u *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (_u *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SubscriptionUpdateOne) Select(field string, fields ...string) *SubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Subscription entity.
+func (_u *SubscriptionUpdateOne) Save(ctx context.Context) (*Subscription, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionUpdateOne) SaveX(ctx context.Context) *Subscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Subscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscription.FieldID)
+		for _, f := range fields {
+			if !subscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Sources(); ok {
+		_spec.SetField(subscription.FieldSources, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldSources, value)
+		})
+	}
+	if value, ok := _u.mutation.MutedSources(); ok {
+		_spec.SetField(subscription.FieldMutedSources, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMutedSources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldMutedSources, value)
+		})
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(subscription.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldKeywords, value)
+		})
+	}
+	if value, ok := _u.mutation.MutedAuthors(); ok {
+		_spec.SetField(subscription.FieldMutedAuthors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMutedAuthors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldMutedAuthors, value)
+		})
+	}
+	if value, ok := _u.mutation.MutedTags(); ok {
+		_spec.SetField(subscription.FieldMutedTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMutedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldMutedTags, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Subscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,22 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Delivery is the client for interacting with the Delivery builders.
+	Delivery *DeliveryClient
+	// ImageCache is the client for interacting with the ImageCache builders.
+	ImageCache *ImageCacheClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PushedCard is the client for interacting with the PushedCard builders.
+	PushedCard *PushedCardClient
+	// SavedPost is the client for interacting with the SavedPost builders.
+	SavedPost *SavedPostClient
+	// State is the client for interacting with the State builders.
+	State *StateClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +159,14 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Delivery = NewDeliveryClient(tx.config)
+	tx.ImageCache = NewImageCacheClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.PushedCard = NewPushedCardClient(tx.config)
+	tx.SavedPost = NewSavedPostClient(tx.config)
+	tx.State = NewStateClient(tx.config)
+	tx.Subscription = NewSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Post.QueryXXX(), the query will be executed
+// applies a query, for example: Delivery.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
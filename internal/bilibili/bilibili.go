@@ -1,11 +1,12 @@
 package bilibili
 
 import (
+	errors2 "errors"
 	"net/http"
-	"os"
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/samber/lo"
 	"github.com/samber/lo/parallel"
 	"github.com/sirupsen/logrus"
@@ -21,14 +22,28 @@ const (
 )
 
 type Client struct {
-	client   *resty.Client
-	keywords []string
+	name      string
+	client    *resty.Client
+	keywords  []string
+	uploaders []int64
+	maxPages  int
+	rules     map[string]*matcher
+
+	// backfillPages caps the walk of a backfill instead of maxPages, as a
+	// backfill usually reaches much further back than a scan.
+	backfillPages int
 }
 
 const Module = "bilibili"
 
+func init() {
+	job.Register(Module, "BILI_", "B站关键词搜索与UP主投稿", DefaultConfig, (*Config).Validate, func(name string, config *Config) (job.MessageProvider, error) {
+		return NewClient(name, config)
+	})
+}
+
 func (c *Client) Name() string {
-	return Module
+	return c.name
 }
 
 type Response[T any] struct {
@@ -37,21 +52,75 @@ type Response[T any] struct {
 	Data    T      `json:"data"`
 }
 
-func NewClient() *Client {
-	cookiesRaw, ok := os.LookupEnv("BILI_COOKIES")
-	if !ok {
-		logrus.Fatalf("env variable COOKIES not set")
+type Config struct {
+	Cookies   string   `yaml:"cookies" env:"COOKIES"`
+	Keywords  []string `yaml:"keywords" env:"KEYWORDS"`
+	Uploaders []int64  `yaml:"uploaders" env:"UPLOADERS"`
+	MaxPages  int      `yaml:"max_pages" env:"MAX_PAGES"`
+	RulesFile string   `yaml:"rules_file" env:"RULES_FILE"`
+	// BackfillMaxPages caps the pages walked by a backfill.
+	BackfillMaxPages int `yaml:"backfill_max_pages" env:"BACKFILL_MAX_PAGES"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Keywords:         []string{"RoboMaster", "机甲大师"},
+		MaxPages:         5,
+		BackfillMaxPages: 50,
+	}
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Cookies == "" {
+		errs = append(errs, errors.New("cookies is required"))
+	} else if _, err := http.ParseCookie(c.Cookies); err != nil {
+		errs = append(errs, errors.Wrap(err, "invalid cookies"))
+	}
+	if len(c.Keywords) == 0 && len(c.Uploaders) == 0 {
+		errs = append(errs, errors.New("keywords or uploaders is required"))
+	}
+	if c.MaxPages <= 0 {
+		errs = append(errs, errors.Errorf("invalid max pages %d", c.MaxPages))
+	}
+	if c.BackfillMaxPages <= 0 {
+		errs = append(errs, errors.Errorf("invalid backfill max pages %d", c.BackfillMaxPages))
+	}
+	if _, err := c.matchers(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors2.Join(errs...)
+}
+
+func (c *Config) keywords() []string {
+	return lo.Compact(lo.Map(c.Keywords, func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	}))
+}
+
+func (c *Config) matchers() (map[string]*matcher, error) {
+	var rules map[string]Rule
+	if c.RulesFile != "" {
+		var err error
+		rules, err = LoadRules(c.RulesFile)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	cookies, err := http.ParseCookie(cookiesRaw)
+	return compileRules(c.keywords(), rules)
+}
+
+func NewClient(name string, config *Config) (*Client, error) {
+	cookies, err := http.ParseCookie(config.Cookies)
 	if err != nil {
-		logrus.Fatalf("failed to parse cookies: %v", err)
+		return nil, errors.Wrap(err, "failed to parse cookies")
 	}
 
-	keywords := "RoboMaster,机甲大师"
-	keywordsOverride, ok := os.LookupEnv("BILI_KEYWORDS")
-	if ok {
-		keywords = keywordsOverride
+	matchers, err := config.matchers()
+	if err != nil {
+		return nil, err
 	}
 
 	c := resty.New().
@@ -63,14 +132,20 @@ func NewClient() *Client {
 		SetHeader("Referer", Referer).
 		SetDebug(utils.Debug).
 		SetCookies(cookies).
-		AddRequestMiddleware(limiter(ratelimit.New(3, ratelimit.Per(time.Minute))))
+		AddRequestMiddleware(limiter(ratelimit.New(3, ratelimit.Per(time.Minute)))).
+		AddRequestMiddleware(newWbiSigner().middleware)
 
-	logrus.Infof("Initialized Bilibili client with keywords: %s", keywords)
+	logrus.Infof("Initialized Bilibili client %s with keywords: %v, uploaders: %v", name, config.Keywords, config.Uploaders)
 
 	return &Client{
-		client:   c,
-		keywords: strings.Split(strings.ToLower(keywords), ","),
-	}
+		name:          name,
+		client:        c,
+		keywords:      config.keywords(),
+		uploaders:     config.Uploaders,
+		maxPages:      config.MaxPages,
+		rules:         matchers,
+		backfillPages: config.BackfillMaxPages,
+	}, nil
 }
 
 func limiter(limiter ratelimit.Limiter) resty.RequestMiddleware {
@@ -81,20 +156,45 @@ func limiter(limiter ratelimit.Limiter) resty.RequestMiddleware {
 }
 
 func (c *Client) Collect() ([]job.Post, error) {
-	results := lo.Flatten(parallel.Map(c.keywords, func(item string, index int) []job.Post {
-		result, err := c.SearchVideos(item)
+	return c.CollectSince(time.Time{})
+}
+
+func (c *Client) CollectSince(since time.Time) ([]job.Post, error) {
+	return c.collect(since, c.maxPages)
+}
+
+// BackfillSince is CollectSince with the backfill page cap.
+func (c *Client) BackfillSince(since time.Time) ([]job.Post, error) {
+	return c.collect(since, c.backfillPages)
+}
+
+func (c *Client) collect(since time.Time, maxPages int) ([]job.Post, error) {
+	results := lo.Flatten(parallel.Map(c.keywords, func(keyword string, index int) []job.Post {
+		result, err := c.searchVideos(keyword, since, maxPages)
 		if err != nil {
-			logrus.Errorf("Failed to search videos with keyword %s: %v", item, err)
+			logrus.Errorf("Failed to search videos with keyword %s: %v", keyword, err)
 			return nil
 		}
 		result = lo.Filter(result, func(item SearchResult, index int) bool {
-			return len(lo.Intersect(strings.Split(strings.ToLower(item.Tag), ","), c.keywords)) > 0
+			return c.rules[keyword].Match(&item)
 		})
 		return lo.Map(result, func(item SearchResult, index int) job.Post {
 			return &item
 		})
 	}))
 
+	// Videos of followed uploaders are kept regardless of their tags.
+	results = append(results, lo.Flatten(parallel.Map(c.uploaders, func(mid int64, index int) []job.Post {
+		result, err := c.searchUploader(mid, since, maxPages)
+		if err != nil {
+			logrus.Errorf("Failed to search videos of uploader %d: %v", mid, err)
+			return nil
+		}
+		return lo.Map(result, func(item SearchResult, index int) job.Post {
+			return &item
+		})
+	}))...)
+
 	results = lo.UniqBy(results, func(item job.Post) string {
 		return item.GetId()
 	})
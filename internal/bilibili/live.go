@@ -0,0 +1,387 @@
+package bilibili
+
+import (
+	"context"
+	errors2 "errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/internal/job"
+	"github.com/wintbiit/rmtv/utils"
+	"go.uber.org/ratelimit"
+	"resty.dev/v3"
+)
+
+const LiveModule = "bilibili-live"
+
+const (
+	LiveStatusOffline  = 0
+	LiveStatusLive     = 1
+	LiveStatusCarousel = 2
+)
+
+type LiveClient struct {
+	name        string
+	client      *resty.Client
+	rooms       []int64
+	notifyEnded bool
+	store       *job.Store
+	states      map[int64]*liveState
+	// pending holds the states of the last collect until its posts are
+	// stored.
+	pending map[int64]*liveState
+}
+
+func init() {
+	job.Register(LiveModule, "BILI_LIVE_", "B站直播开播与下播提醒", DefaultLiveConfig, (*LiveConfig).Validate, func(name string, config *LiveConfig) (job.MessageProvider, error) {
+		return NewLiveClient(name, config)
+	})
+}
+
+func (c *LiveClient) Name() string {
+	return c.name
+}
+
+func (c *LiveClient) SetStore(store *job.Store) {
+	c.store = store
+}
+
+type LiveConfig struct {
+	Rooms       []int64 `yaml:"rooms" env:"ROOMS"`
+	NotifyEnded bool    `yaml:"notify_ended" env:"NOTIFY_ENDED"`
+	// Cookies are optional, defaulting to BILI_COOKIES.
+	Cookies string `yaml:"cookies" env:"COOKIES"`
+}
+
+func DefaultLiveConfig() *LiveConfig {
+	return &LiveConfig{
+		Cookies: os.Getenv("BILI_COOKIES"),
+	}
+}
+
+func (c *LiveConfig) Validate() error {
+	var errs []error
+	if len(c.Rooms) == 0 {
+		errs = append(errs, errors.New("rooms is required"))
+	}
+	if c.Cookies != "" {
+		if _, err := http.ParseCookie(c.Cookies); err != nil {
+			errs = append(errs, errors.Wrap(err, "invalid cookies"))
+		}
+	}
+
+	return errors2.Join(errs...)
+}
+
+func NewLiveClient(name string, config *LiveConfig) (*LiveClient, error) {
+	c := resty.New().
+		SetBaseURL("https://api.live.bilibili.com/").
+		SetRetryCount(3).
+		SetRetryMaxWaitTime(5*1000).
+		SetRetryWaitTime(1*1000).
+		SetHeader("User-Agent", UA).
+		SetHeader("Referer", "https://live.bilibili.com/").
+		SetDebug(utils.Debug).
+		AddRequestMiddleware(limiter(ratelimit.New(20, ratelimit.Per(time.Minute))))
+
+	if config.Cookies != "" {
+		cookies, err := http.ParseCookie(config.Cookies)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse cookies")
+		}
+		c.SetCookies(cookies)
+	}
+
+	logrus.Infof("Initialized Bilibili live client %s with rooms: %v", name, config.Rooms)
+
+	return &LiveClient{
+		name:        name,
+		client:      c,
+		rooms:       config.Rooms,
+		notifyEnded: config.NotifyEnded,
+		states:      make(map[int64]*liveState),
+	}, nil
+}
+
+type RoomInfoResponse struct {
+	RoomInfo struct {
+		Uid            int64  `json:"uid"`
+		RoomId         int64  `json:"room_id"`
+		ShortId        int64  `json:"short_id"`
+		Title          string `json:"title"`
+		Cover          string `json:"cover"`
+		Description    string `json:"description"`
+		Tags           string `json:"tags"`
+		LiveStatus     int    `json:"live_status"`
+		LiveStartTime  int64  `json:"live_start_time"`
+		AreaName       string `json:"area_name"`
+		ParentAreaName string `json:"parent_area_name"`
+		Online         int    `json:"online"`
+	} `json:"room_info"`
+	AnchorInfo struct {
+		BaseInfo struct {
+			Uname string `json:"uname"`
+			Face  string `json:"face"`
+		} `json:"base_info"`
+	} `json:"anchor_info"`
+}
+
+func (c *LiveClient) GetRoomInfo(room int64) (*RoomInfoResponse, error) {
+	resp, err := c.client.R().
+		SetQueryParam("room_id", strconv.FormatInt(room, 10)).
+		SetResult(Response[RoomInfoResponse]{}).
+		Get("xlive/web-room/v1/index/getInfoByRoom")
+	if err != nil {
+		return nil, errors.Wrap(err, "get room info error")
+	}
+
+	if !resp.IsSuccess() {
+		return nil, errors.Errorf("get room info failed: %s", resp.String())
+	}
+
+	infoResp := resp.Result().(*Response[RoomInfoResponse])
+	if infoResp.Code != 0 {
+		return nil, errors.Errorf("get room info failed: %d %s", infoResp.Code, infoResp.Message)
+	}
+
+	return &infoResp.Data, nil
+}
+
+// liveState is the last known state of a room, persisted so that a restart
+// neither re-announces a running stream nor misses its end.
+type liveState struct {
+	Live      bool      `json:"live"`
+	StartedAt int64     `json:"started_at"`
+	Post      *LivePost `json:"post,omitempty"`
+}
+
+func (c *LiveClient) stateKey(room int64) string {
+	return fmt.Sprintf("%s:%d", c.name, room)
+}
+
+func (c *LiveClient) loadState(ctx context.Context, room int64) (*liveState, error) {
+	if state, ok := c.states[room]; ok {
+		return state, nil
+	}
+
+	state := &liveState{}
+	if c.store != nil {
+		if _, err := c.store.Get(ctx, c.stateKey(room), state); err != nil {
+			return nil, err
+		}
+	}
+
+	c.states[room] = state
+	return state, nil
+}
+
+func (c *LiveClient) saveState(ctx context.Context, room int64, state *liveState) error {
+	c.states[room] = state
+	if c.store == nil {
+		return nil
+	}
+
+	return c.store.Put(ctx, c.stateKey(room), state)
+}
+
+func (c *LiveClient) Collect() ([]job.Post, error) {
+	ctx := context.Background()
+	results := make([]job.Post, 0)
+	c.pending = make(map[int64]*liveState)
+
+	for _, room := range c.rooms {
+		info, err := c.GetRoomInfo(room)
+		if err != nil {
+			logrus.Errorf("Failed to get live room %d: %v", room, err)
+			continue
+		}
+
+		state, err := c.loadState(ctx, room)
+		if err != nil {
+			return nil, err
+		}
+
+		// A running stream is returned on every scan, the stored post ID keeps
+		// it from being announced twice. The state only remembers it for the
+		// ended event.
+		live := info.RoomInfo.LiveStatus == LiveStatusLive
+		switch {
+		case live:
+			post := newLivePost(info)
+			results = append(results, post)
+			if state.Live && state.StartedAt == info.RoomInfo.LiveStartTime {
+				continue
+			}
+			state = &liveState{
+				Live:      true,
+				StartedAt: info.RoomInfo.LiveStartTime,
+				Post:      post,
+			}
+		case state.Live:
+			if c.notifyEnded && state.Post != nil {
+				results = append(results, state.Post.ended(time.Now()))
+			}
+			state = &liveState{}
+		default:
+			continue
+		}
+
+		c.pending[room] = state
+	}
+
+	return results, nil
+}
+
+// Commit saves the room states of the last collect, once its started and
+// ended posts are stored.
+func (c *LiveClient) Commit(ctx context.Context) error {
+	for room, state := range c.pending {
+		if err := c.saveState(ctx, room, state); err != nil {
+			return err
+		}
+		delete(c.pending, room)
+	}
+
+	return nil
+}
+
+type LivePost struct {
+	RoomId    int64     `json:"room_id"`
+	Uid       int64     `json:"uid"`
+	Uname     string    `json:"uname"`
+	Title     string    `json:"title"`
+	Cover     string    `json:"cover"`
+	Area      string    `json:"area"`
+	Online    int       `json:"online"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at,omitempty"`
+}
+
+func newLivePost(info *RoomInfoResponse) *LivePost {
+	return &LivePost{
+		RoomId:    info.RoomInfo.RoomId,
+		Uid:       info.RoomInfo.Uid,
+		Uname:     info.AnchorInfo.BaseInfo.Uname,
+		Title:     info.RoomInfo.Title,
+		Cover:     info.RoomInfo.Cover,
+		Area:      strings.Trim(info.RoomInfo.ParentAreaName+" · "+info.RoomInfo.AreaName, " ·"),
+		Online:    info.RoomInfo.Online,
+		StartedAt: time.Unix(info.RoomInfo.LiveStartTime, 0),
+	}
+}
+
+func (l *LivePost) ended(at time.Time) *LivePost {
+	post := *l
+	post.EndedAt = at
+	return &post
+}
+
+func (l *LivePost) isEnded() bool {
+	return !l.EndedAt.IsZero()
+}
+
+func (l *LivePost) GetType() string {
+	if l.isEnded() {
+		return "直播结束"
+	}
+
+	return "直播"
+}
+
+func (l *LivePost) GetTypeColor() string {
+	if l.isEnded() {
+		return "grey"
+	}
+
+	return "orange"
+}
+
+func (l *LivePost) GetId() string {
+	id := fmt.Sprintf("live-%d-%d", l.RoomId, l.StartedAt.Unix())
+	if l.isEnded() {
+		id += "-end"
+	}
+
+	return id
+}
+
+func (l *LivePost) GetPic() *string {
+	if l.Cover == "" {
+		return nil
+	}
+
+	return &l.Cover
+}
+
+func (l *LivePost) GetTitle() string {
+	return l.Title
+}
+
+func (l *LivePost) GetDesc() string {
+	if l.isEnded() {
+		return fmt.Sprintf("%s 的直播已结束", l.Uname)
+	}
+
+	return fmt.Sprintf("%s 开播了", l.Uname)
+}
+
+func (l *LivePost) GetTags() []string {
+	return lo.Compact(strings.Split(l.Area, " · "))
+}
+
+func (l *LivePost) GetPubDate() time.Time {
+	if l.isEnded() {
+		return l.EndedAt
+	}
+
+	return l.StartedAt
+}
+
+func (l *LivePost) GetAuthor() string {
+	return l.Uname
+}
+
+func (l *LivePost) GetAuthorUrl() string {
+	return fmt.Sprintf("https://space.bilibili.com/%d", l.Uid)
+}
+
+func (l *LivePost) GetUrl() string {
+	return fmt.Sprintf("https://live.bilibili.com/%d", l.RoomId)
+}
+
+type LiveExtra struct {
+	Area     string        `json:"area"`
+	Online   int           `json:"online"`
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+func (e *LiveExtra) String() string {
+	if e.Duration > 0 {
+		return fmt.Sprintf("<text_tag color='orange'>📺 %s</text_tag> "+
+			"<text_tag color='red'>⌛️ %s</text_tag>",
+			e.Area, e.Duration.Round(time.Minute))
+	}
+
+	return fmt.Sprintf("<text_tag color='orange'>📺 %s</text_tag> "+
+		"<text_tag color='blue'>👀 %d</text_tag>",
+		e.Area, e.Online)
+}
+
+func (l *LivePost) GetExtra() job.PostExtra {
+	extra := &LiveExtra{
+		Area:   l.Area,
+		Online: l.Online,
+	}
+	if l.isEnded() {
+		extra.Duration = l.EndedAt.Sub(l.StartedAt)
+	}
+
+	return extra
+}
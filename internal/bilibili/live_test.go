@@ -0,0 +1,107 @@
+package bilibili
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/internal/job"
+	"resty.dev/v3"
+)
+
+// newTestLiveClient serves the fixture named by *fixture for every room.
+func newTestLiveClient(t *testing.T, fixture *string) *LiveClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/xlive/web-room/v1/index/getInfoByRoom" {
+			http.NotFound(w, r)
+			return
+		}
+		serveFixture(t, w, *fixture)
+	}))
+	t.Cleanup(srv.Close)
+
+	return &LiveClient{
+		name:        LiveModule,
+		client:      resty.New().SetBaseURL(srv.URL + "/"),
+		rooms:       []int64{22259479},
+		notifyEnded: true,
+		states:      make(map[int64]*liveState),
+	}
+}
+
+func collectIds(t *testing.T, c *LiveClient, commit bool) []string {
+	posts, err := c.Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commit {
+		if err := c.Commit(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return lo.Map(posts, func(item job.Post, _ int) string {
+		return item.GetId()
+	})
+}
+
+func TestLiveTransitions(t *testing.T) {
+	fixture := "live_room_offline.json"
+	client := newTestLiveClient(t, &fixture)
+
+	steps := []struct {
+		fixture string
+		want    []string
+	}{
+		{"live_room_offline.json", []string{}},
+		{"live_room.json", []string{"live-22259479-1752805800"}},
+		{"live_room.json", []string{"live-22259479-1752805800"}},
+		{"live_room_offline.json", []string{"live-22259479-1752805800-end"}},
+		{"live_room_offline.json", []string{}},
+		{"live_room_restarted.json", []string{"live-22259479-1752892200"}},
+	}
+	for i, step := range steps {
+		fixture = step.fixture
+		if got := collectIds(t, client, true); !slices.Equal(got, step.want) {
+			t.Errorf("step %d: got %v, want %v", i, got, step.want)
+		}
+	}
+}
+
+func TestLiveEndedAfterRestart(t *testing.T) {
+	fixture := "live_room.json"
+	client := newTestLiveClient(t, &fixture)
+	collectIds(t, client, true)
+
+	// A restarted scanner loads the state the previous one saved.
+	restarted := newTestLiveClient(t, &fixture)
+	restarted.states = client.states
+
+	if got := collectIds(t, restarted, true); !slices.Equal(got, []string{"live-22259479-1752805800"}) {
+		t.Errorf("running stream: got %v", got)
+	}
+
+	fixture = "live_room_offline.json"
+	if got := collectIds(t, restarted, true); !slices.Equal(got, []string{"live-22259479-1752805800-end"}) {
+		t.Errorf("ended stream: got %v", got)
+	}
+}
+
+func TestLiveStateKeptUntilCommit(t *testing.T) {
+	fixture := "live_room.json"
+	client := newTestLiveClient(t, &fixture)
+	collectIds(t, client, true)
+
+	// The ended post failed to store, so the next scan reports it again.
+	fixture = "live_room_offline.json"
+	collectIds(t, client, false)
+	if got := collectIds(t, client, true); !slices.Equal(got, []string{"live-22259479-1752805800-end"}) {
+		t.Errorf("got %v, want the ended post again", got)
+	}
+	if got := collectIds(t, client, true); len(got) != 0 {
+		t.Errorf("got %v after commit", got)
+	}
+}
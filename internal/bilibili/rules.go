@@ -0,0 +1,208 @@
+package bilibili
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldTags        = "tags"
+	FieldAuthor      = "author"
+
+	// DefaultRuleKey is the rule applied to keywords without their own rule.
+	DefaultRuleKey = "*"
+)
+
+// Rule decides which search results of a keyword are kept. A result is kept
+// when any include term or regex matches one of the fields, no exclude term or
+// regex matches, and its uploader is not blocked. Terms match tags as a whole
+// and other fields as substrings. Without include terms and regexes the
+// keyword itself is the include term. Uploaders blocked by the default rule
+// are blocked for every keyword.
+type Rule struct {
+	Include       []string `json:"include"`
+	Exclude       []string `json:"exclude"`
+	Regex         []string `json:"regex"`
+	ExcludeRegex  []string `json:"exclude_regex"`
+	Fields        []string `json:"fields"`
+	CaseSensitive bool     `json:"case_sensitive"`
+	BlockedMids   []int    `json:"blocked_mids"`
+}
+
+type matcher struct {
+	rule         Rule
+	regex        []*regexp.Regexp
+	excludeRegex []*regexp.Regexp
+}
+
+// LoadRules reads keyword rules from a JSON object keyed by keyword.
+func LoadRules(path string) (map[string]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read rules")
+	}
+
+	var rules map[string]Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, errors.Wrap(err, "failed to parse rules")
+	}
+
+	return rules, nil
+}
+
+func compileRule(keyword string, rule Rule) (*matcher, error) {
+	if len(rule.Include) == 0 && len(rule.Regex) == 0 {
+		rule.Include = []string{keyword}
+	}
+	if len(rule.Fields) == 0 {
+		rule.Fields = []string{FieldTitle, FieldTags}
+	}
+
+	for _, field := range rule.Fields {
+		if !lo.Contains([]string{FieldTitle, FieldDescription, FieldTags, FieldAuthor}, field) {
+			return nil, errors.Errorf("unknown field %q in rule of %s", field, keyword)
+		}
+	}
+
+	if !rule.CaseSensitive {
+		rule.Include = lo.Map(rule.Include, func(item string, _ int) string {
+			return strings.ToLower(item)
+		})
+		rule.Exclude = lo.Map(rule.Exclude, func(item string, _ int) string {
+			return strings.ToLower(item)
+		})
+	}
+
+	compile := func(exprs []string) ([]*regexp.Regexp, error) {
+		regex := make([]*regexp.Regexp, 0, len(exprs))
+		for _, expr := range exprs {
+			if !rule.CaseSensitive {
+				expr = "(?i)" + expr
+			}
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid regex in rule of %s", keyword)
+			}
+			regex = append(regex, re)
+		}
+		return regex, nil
+	}
+
+	regex, err := compile(rule.Regex)
+	if err != nil {
+		return nil, err
+	}
+	excludeRegex, err := compile(rule.ExcludeRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &matcher{
+		rule:         rule,
+		regex:        regex,
+		excludeRegex: excludeRegex,
+	}, nil
+}
+
+// compileRules builds a matcher for every keyword, falling back to the
+// default rule and then to the keyword itself. Rules are looked up ignoring
+// case, while the keyword keeps its case for case sensitive rules.
+func compileRules(keywords []string, rules map[string]Rule) (map[string]*matcher, error) {
+	rules = lo.MapKeys(rules, func(_ Rule, key string) string {
+		return strings.ToLower(key)
+	})
+	blocked := rules[DefaultRuleKey].BlockedMids
+
+	matchers := make(map[string]*matcher, len(keywords))
+	for _, keyword := range keywords {
+		rule, ok := rules[strings.ToLower(keyword)]
+		if !ok {
+			rule = rules[DefaultRuleKey]
+		}
+		rule.BlockedMids = lo.Uniq(append(slices.Clone(rule.BlockedMids), blocked...))
+
+		m, err := compileRule(keyword, rule)
+		if err != nil {
+			return nil, err
+		}
+		matchers[keyword] = m
+	}
+
+	return matchers, nil
+}
+
+func (m *matcher) fold(s string) string {
+	if m.rule.CaseSensitive {
+		return s
+	}
+
+	return strings.ToLower(s)
+}
+
+func (m *matcher) texts(s *SearchResult) []string {
+	texts := make([]string, 0, len(m.rule.Fields))
+	for _, field := range m.rule.Fields {
+		switch field {
+		case FieldTitle:
+			texts = append(texts, strings.ReplaceAll(s.Title, "**", ""))
+		case FieldDescription:
+			texts = append(texts, s.Description)
+		case FieldAuthor:
+			texts = append(texts, s.Author)
+		}
+	}
+
+	return texts
+}
+
+func (m *matcher) tags(s *SearchResult) []string {
+	if !lo.Contains(m.rule.Fields, FieldTags) {
+		return nil
+	}
+
+	return lo.Map(s.GetTags(), func(item string, _ int) string {
+		return m.fold(strings.TrimSpace(item))
+	})
+}
+
+func (m *matcher) containsAny(s *SearchResult, terms []string) bool {
+	tags := m.tags(s)
+	texts := lo.Map(m.texts(s), func(item string, _ int) string {
+		return m.fold(item)
+	})
+
+	return lo.SomeBy(terms, func(term string) bool {
+		return lo.Contains(tags, term) || lo.SomeBy(texts, func(text string) bool {
+			return strings.Contains(text, term)
+		})
+	})
+}
+
+func (m *matcher) matchesAny(s *SearchResult, regex []*regexp.Regexp) bool {
+	texts := append(m.texts(s), m.tags(s)...)
+
+	return lo.SomeBy(regex, func(re *regexp.Regexp) bool {
+		return lo.SomeBy(texts, re.MatchString)
+	})
+}
+
+// Match reports whether the search result is kept by the rule.
+func (m *matcher) Match(s *SearchResult) bool {
+	if lo.Contains(m.rule.BlockedMids, s.Mid) {
+		return false
+	}
+
+	if !m.containsAny(s, m.rule.Include) && !m.matchesAny(s, m.regex) {
+		return false
+	}
+
+	return !m.containsAny(s, m.rule.Exclude) && !m.matchesAny(s, m.excludeRegex)
+}
@@ -0,0 +1,131 @@
+package bilibili
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+)
+
+func loadSearchFixture(t *testing.T) []SearchResult {
+	data, err := os.ReadFile(filepath.Join("testdata", "search.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resp Response[SearchVideoResponse]
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	return lo.Map(resp.Data.Result, func(item SearchResult, _ int) SearchResult {
+		item.postprocess()
+		return item
+	})
+}
+
+func TestRules(t *testing.T) {
+	results := loadSearchFixture(t)
+
+	rules, err := LoadRules(filepath.Join("testdata", "rules.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keywords := []string{"robomaster", "视觉", "rm", "机甲大师"}
+	matchers, err := compileRules(keywords, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string][]string{
+		// Matches the title even though the second video is not tagged robomaster.
+		"robomaster": {"BV1RM4y1a7Aa", "BV1RM4y1a7Ab"},
+		"视觉":         {"BV1RM4y1a7Ab"},
+		"rm":         {"BV1RM4y1a7Ab"},
+		// Falls back to the default rule, which blocks the reposting uploader.
+		"机甲大师": {"BV1RM4y1a7Aa"},
+	}
+
+	for keyword, want := range cases {
+		got := lo.FilterMap(results, func(item SearchResult, _ int) (string, bool) {
+			return item.BVID, matchers[keyword].Match(&item)
+		})
+		if !lo.ElementsMatch(got, want) {
+			t.Errorf("%s: got %v, want %v", keyword, got, want)
+		}
+	}
+}
+
+func TestRulesCaseSensitive(t *testing.T) {
+	results := loadSearchFixture(t)
+
+	m, err := compileRule("rm", Rule{Include: []string{"rm"}, Fields: []string{FieldTags}, CaseSensitive: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lo.SomeBy(results, func(item SearchResult) bool {
+		return m.Match(&item)
+	}) {
+		t.Error("case sensitive rule matched a differently cased tag")
+	}
+}
+
+func TestRulesDefaultBlockedMids(t *testing.T) {
+	results := loadSearchFixture(t)
+
+	matchers, err := compileRules([]string{"机甲大师"}, map[string]Rule{
+		DefaultRuleKey: {BlockedMids: []int{20002}},
+		"机甲大师":         {Include: []string{"机甲大师"}, BlockedMids: []int{30003}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The keyword has its own rule, the uploader blocked by the default rule
+	// is blocked anyway.
+	got := lo.FilterMap(results, func(item SearchResult, _ int) (string, bool) {
+		return item.BVID, matchers["机甲大师"].Match(&item)
+	})
+	if !lo.ElementsMatch(got, []string{"BV1RM4y1a7Aa"}) {
+		t.Errorf("got %v", got)
+	}
+	if !lo.ElementsMatch(matchers["机甲大师"].rule.BlockedMids, []int{20002, 30003}) {
+		t.Errorf("unexpected blocked mids: %v", matchers["机甲大师"].rule.BlockedMids)
+	}
+}
+
+func TestRulesCaseSensitiveKeyword(t *testing.T) {
+	results := loadSearchFixture(t)
+
+	config := &Config{Keywords: []string{" RoboMaster "}}
+	keywords := config.keywords()
+	matchers, err := compileRules(keywords, map[string]Rule{
+		"robomaster": {CaseSensitive: true, Fields: []string{FieldTitle}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without include terms the keyword keeps its case, so the mixed case
+	// titles still match.
+	got := lo.FilterMap(results, func(item SearchResult, _ int) (string, bool) {
+		return item.BVID, matchers[keywords[0]].Match(&item)
+	})
+	if !lo.ElementsMatch(got, []string{"BV1RM4y1a7Aa", "BV1RM4y1a7Ab"}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestRulesInvalid(t *testing.T) {
+	if _, err := compileRule("robomaster", Rule{Regex: []string{"("}}); err == nil {
+		t.Error("expected invalid regex error")
+	}
+
+	if _, err := compileRule("robomaster", Rule{Fields: []string{"cover"}}); err == nil {
+		t.Error("expected unknown field error")
+	}
+}
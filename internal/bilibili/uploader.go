@@ -0,0 +1,123 @@
+package bilibili
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type SpaceArcSearchResponse struct {
+	List struct {
+		Vlist []SpaceVideo `json:"vlist"`
+	} `json:"list"`
+	Page struct {
+		Pn    int `json:"pn"`
+		Ps    int `json:"ps"`
+		Count int `json:"count"`
+	} `json:"page"`
+}
+
+type SpaceVideo struct {
+	Comment     int    `json:"comment"`
+	TypeID      int    `json:"typeid"`
+	Play        int    `json:"play"`
+	Pic         string `json:"pic"`
+	Description string `json:"description"`
+	Title       string `json:"title"`
+	Author      string `json:"author"`
+	Mid         int    `json:"mid"`
+	Created     int    `json:"created"`
+	Length      string `json:"length"`
+	VideoReview int    `json:"video_review"`
+	Aid         int    `json:"aid"`
+	BVID        string `json:"bvid"`
+}
+
+// toSearchResult maps an uploader's video onto a search result, so both
+// collection modes share the same post type.
+func (v SpaceVideo) toSearchResult() SearchResult {
+	return SearchResult{
+		Type:        SearchResultTypeVideo,
+		ID:          v.Aid,
+		Author:      v.Author,
+		Mid:         v.Mid,
+		TypeID:      strconv.Itoa(v.TypeID),
+		Aid:         v.Aid,
+		BVID:        v.BVID,
+		Title:       v.Title,
+		Description: v.Description,
+		Pic:         v.Pic,
+		Play:        v.Play,
+		VideoReview: v.VideoReview,
+		Review:      v.Comment,
+		PubDate:     v.Created,
+		SendDate:    v.Created,
+		Duration:    v.Length,
+	}
+}
+
+const spacePageSize = 30
+
+// SearchUploader walks the videos of the uploader mid, newest first, until it
+// reaches videos published before since, the last page or the page cap.
+func (c *Client) SearchUploader(mid int64, since time.Time) ([]SearchResult, error) {
+	return c.searchUploader(mid, since, c.maxPages)
+}
+
+func (c *Client) searchUploader(mid int64, since time.Time, maxPages int) ([]SearchResult, error) {
+	results := make([]SearchResult, 0)
+	for page := 1; ; page++ {
+		data, err := c.searchUploaderPage(mid, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range data.List.Vlist {
+			results = append(results, item.toSearchResult())
+		}
+
+		vlist := data.List.Vlist
+		if len(vlist) == 0 || page*data.Page.Ps >= data.Page.Count {
+			break
+		}
+
+		if !time.Unix(int64(vlist[len(vlist)-1].Created), 0).After(since) {
+			break
+		}
+
+		if page >= maxPages {
+			if !since.IsZero() {
+				logrus.Warnf("Stopped searching videos of uploader %d at page %d before reaching %s", mid, page, since.Format(time.DateOnly))
+			}
+			break
+		}
+	}
+
+	return results, nil
+}
+
+func (c *Client) searchUploaderPage(mid int64, page int) (*SpaceArcSearchResponse, error) {
+	resp, err := c.client.R().
+		SetQueryParam("mid", strconv.FormatInt(mid, 10)).
+		SetQueryParam("order", "pubdate").
+		SetQueryParam("ps", strconv.Itoa(spacePageSize)).
+		SetQueryParam("pn", strconv.Itoa(page)).
+		SetResult(Response[SpaceArcSearchResponse]{}).
+		Get("space/wbi/arc/search")
+	if err != nil {
+		return nil, errors.Wrap(err, "search uploader error")
+	}
+
+	if !resp.IsSuccess() {
+		return nil, errors.Errorf("search uploader failed: %s", resp.String())
+	}
+
+	searchResp := resp.Result().(*Response[SpaceArcSearchResponse])
+	if searchResp.Code != 0 {
+		return nil, errors.Errorf("search uploader failed: %d %s", searchResp.Code, searchResp.Message)
+	}
+
+	return &searchResp.Data, nil
+}
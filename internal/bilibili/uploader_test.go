@@ -0,0 +1,53 @@
+package bilibili
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCollectUploaders(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/x/web-interface/nav", func(w http.ResponseWriter, r *http.Request) {
+		serveFixture(t, w, "nav.json")
+	})
+	mux.HandleFunc("/x/web-interface/wbi/search/type", func(w http.ResponseWriter, r *http.Request) {
+		serveFixture(t, w, "search.json")
+	})
+	mux.HandleFunc("/x/space/wbi/arc/search", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("mid") != "39287616" {
+			t.Errorf("unexpected mid: %s", r.URL.Query().Get("mid"))
+		}
+		if r.URL.Query().Get("w_rid") == "" {
+			t.Errorf("request not signed: %s", r.URL.RawQuery)
+		}
+		serveFixture(t, w, "space.json")
+	})
+
+	client := newTestClient(t, mux)
+	client.uploaders = []int64{39287616}
+
+	posts, err := client.CollectSince(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make(map[string]int)
+	for _, post := range posts {
+		ids[post.GetId()]++
+	}
+
+	// BV1RM4y1a7Aa is both tagged robomaster and uploaded by the followed
+	// account, BV1RM4y1a7Ad only comes from the uploader listing.
+	for _, id := range []string{"BV1RM4y1a7Aa", "BV1RM4y1a7Ad"} {
+		if ids[id] != 1 {
+			t.Errorf("expected %s exactly once, got %d", id, ids[id])
+		}
+	}
+
+	for _, post := range posts {
+		if post.GetId() == "BV1RM4y1a7Ad" && post.GetAuthorUrl() != "https://space.bilibili.com/39287616" {
+			t.Errorf("unexpected author url: %s", post.GetAuthorUrl())
+		}
+	}
+}
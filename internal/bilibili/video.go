@@ -3,11 +3,13 @@ package bilibili
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 	"github.com/wintbiit/rmtv/internal/job"
 )
 
@@ -107,7 +109,7 @@ func (s *SearchResult) GetDesc() string {
 }
 
 func (s *SearchResult) GetTags() []string {
-	return strings.Split(s.Tag, ",")
+	return lo.Compact(strings.Split(s.Tag, ","))
 }
 
 func (s *SearchResult) GetPubDate() time.Time {
@@ -147,11 +149,50 @@ func (s *SearchResult) postprocess() {
 	s.Title = titleRegex.ReplaceAllString(s.Title, `**$1**`)
 }
 
-func (c *Client) SearchVideos(keyword string) ([]SearchResult, error) {
+// SearchVideos walks the search results of keyword, newest first, until it
+// reaches videos published before since, the last page or the page cap.
+func (c *Client) SearchVideos(keyword string, since time.Time) ([]SearchResult, error) {
+	return c.searchVideos(keyword, since, c.maxPages)
+}
+
+func (c *Client) searchVideos(keyword string, since time.Time, maxPages int) ([]SearchResult, error) {
+	results := make([]SearchResult, 0)
+	for page := 1; ; page++ {
+		data, err := c.searchVideosPage(keyword, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range data.Result {
+			item.postprocess()
+			results = append(results, item)
+		}
+
+		if len(data.Result) == 0 || page >= data.NumPages {
+			break
+		}
+
+		if !data.Result[len(data.Result)-1].GetPubDate().After(since) {
+			break
+		}
+
+		if page >= maxPages {
+			if !since.IsZero() {
+				logrus.Warnf("Stopped searching videos with keyword %s at page %d before reaching %s", keyword, page, since.Format(time.DateOnly))
+			}
+			break
+		}
+	}
+
+	return results, nil
+}
+
+func (c *Client) searchVideosPage(keyword string, page int) (*SearchVideoResponse, error) {
 	resp, err := c.client.R().
 		SetQueryParam("search_type", SearchResultTypeVideo).
 		SetQueryParam("keyword", keyword).
 		SetQueryParam("order", "pubdate").
+		SetQueryParam("page", strconv.Itoa(page)).
 		SetResult(Response[SearchVideoResponse]{}).
 		Get("web-interface/wbi/search/type")
 	if err != nil {
@@ -167,9 +208,5 @@ func (c *Client) SearchVideos(keyword string) ([]SearchResult, error) {
 		return nil, errors.Errorf("search videos failed: %d %s", searchResp.Code, searchResp.Message)
 	}
 
-	return lo.Map(searchResp.Data.Result, func(item SearchResult, _ int) SearchResult {
-		i := item
-		i.postprocess()
-		return i
-	}), nil
+	return &searchResp.Data, nil
 }
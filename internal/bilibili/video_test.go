@@ -1,11 +1,25 @@
 package bilibili
 
-import "testing"
+import (
+	"os"
+	"testing"
+	"time"
+)
 
 func TestSearchVideo(t *testing.T) {
-	client := NewClient()
+	cookies, ok := os.LookupEnv("BILI_COOKIES")
+	if !ok {
+		t.Skip("BILI_COOKIES not set")
+	}
+
+	config := DefaultConfig()
+	config.Cookies = cookies
+	client, err := NewClient(Module, config)
+	if err != nil {
+		t.Fatal(err)
+	}
 
-	videos, err := client.SearchVideos("RoboMaster")
+	videos, err := client.SearchVideos("RoboMaster", time.Now().Add(-24*time.Hour))
 	if err != nil {
 		t.Fatalf("failed to search videos: %v", err)
 	}
@@ -0,0 +1,141 @@
+package bilibili
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"resty.dev/v3"
+)
+
+// mixinKeyEncTab shuffles img_key + sub_key into the WBI mixin key.
+var mixinKeyEncTab = []int{
+	46, 47, 18, 2, 53, 8, 23, 32, 15, 50, 10, 31, 58, 3, 45, 35, 27, 43, 5, 49,
+	33, 9, 42, 19, 29, 28, 14, 39, 12, 38, 41, 13, 37, 48, 7, 16, 24, 55, 40,
+	61, 26, 17, 0, 1, 60, 51, 30, 4, 22, 25, 54, 21, 56, 59, 6, 63, 57, 62, 11,
+	36, 20, 34, 44, 52,
+}
+
+const wbiKeysTTL = time.Hour
+
+type NavResponse struct {
+	IsLogin bool `json:"isLogin"`
+	WbiImg  struct {
+		ImgUrl string `json:"img_url"`
+		SubUrl string `json:"sub_url"`
+	} `json:"wbi_img"`
+}
+
+// wbiSigner caches the mixin key derived from the nav endpoint and signs
+// requests to WBI endpoints with it.
+type wbiSigner struct {
+	mu        sync.Mutex
+	mixinKey  string
+	fetchedAt time.Time
+	now       func() time.Time
+}
+
+func newWbiSigner() *wbiSigner {
+	return &wbiSigner{
+		now: time.Now,
+	}
+}
+
+func mixinKey(imgKey, subKey string) string {
+	raw := imgKey + subKey
+	var key strings.Builder
+	for _, i := range mixinKeyEncTab {
+		if i < len(raw) {
+			key.WriteByte(raw[i])
+		}
+	}
+
+	return key.String()[:min(32, key.Len())]
+}
+
+func keyFromUrl(u string) string {
+	return strings.TrimSuffix(path.Base(u), path.Ext(u))
+}
+
+func (s *wbiSigner) key(client *resty.Client) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mixinKey != "" && s.now().Sub(s.fetchedAt) < wbiKeysTTL {
+		return s.mixinKey, nil
+	}
+
+	// The nav endpoint answers with code -101 when not logged in, but still
+	// carries the WBI keys.
+	resp, err := client.R().
+		SetResult(Response[NavResponse]{}).
+		Get("web-interface/nav")
+	if err != nil {
+		return "", errors.Wrap(err, "fetch wbi keys error")
+	}
+
+	if !resp.IsSuccess() {
+		return "", errors.Errorf("fetch wbi keys failed: %s", resp.String())
+	}
+
+	nav := resp.Result().(*Response[NavResponse])
+	imgKey, subKey := keyFromUrl(nav.Data.WbiImg.ImgUrl), keyFromUrl(nav.Data.WbiImg.SubUrl)
+	if imgKey == "" || subKey == "" {
+		return "", errors.Errorf("fetch wbi keys failed: %d %s", nav.Code, nav.Message)
+	}
+
+	s.mixinKey = mixinKey(imgKey, subKey)
+	s.fetchedAt = s.now()
+
+	return s.mixinKey, nil
+}
+
+// sign adds wts and w_rid to params, replacing a previous signature.
+func sign(params url.Values, mixinKey string, wts time.Time) {
+	params.Del("w_rid")
+	params.Set("wts", strconv.FormatInt(wts.Unix(), 10))
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	query := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := strings.Map(func(r rune) rune {
+			if strings.ContainsRune("!'()*", r) {
+				return -1
+			}
+			return r
+		}, params.Get(k))
+		params.Set(k, v)
+		query = append(query, url.QueryEscape(k)+"="+url.QueryEscape(v))
+	}
+
+	hash := md5.Sum([]byte(strings.ReplaceAll(strings.Join(query, "&"), "+", "%20") + mixinKey))
+	params.Set("w_rid", hex.EncodeToString(hash[:]))
+}
+
+// middleware signs every request to a WBI endpoint.
+func (s *wbiSigner) middleware(client *resty.Client, req *resty.Request) error {
+	if !strings.Contains(req.URL, "/wbi/") {
+		return nil
+	}
+
+	key, err := s.key(client)
+	if err != nil {
+		return err
+	}
+
+	sign(req.QueryParams, key, s.now())
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package bilibili
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"resty.dev/v3"
+)
+
+const testMixinKey = "ea1db124af3c7062474693fa704f4ff8"
+
+func TestMixinKey(t *testing.T) {
+	key := mixinKey("7cd084941338484aae1ad9425b84077c", "4932caff0ff746eab6f01bf08b70ac45")
+	if key != testMixinKey {
+		t.Fatalf("unexpected mixin key: %s", key)
+	}
+}
+
+func TestSign(t *testing.T) {
+	params := url.Values{
+		"foo": {"114"},
+		"bar": {"514"},
+		"zab": {"1919810"},
+	}
+
+	sign(params, testMixinKey, time.Unix(1702204169, 0))
+
+	if params.Get("wts") != "1702204169" {
+		t.Fatalf("unexpected wts: %s", params.Get("wts"))
+	}
+	if params.Get("w_rid") != "8f6f2b5b3d485fe1886cec6a0be8c5d4" {
+		t.Fatalf("unexpected w_rid: %s", params.Get("w_rid"))
+	}
+
+	// Signing again must replace the previous signature, not include it.
+	sign(params, testMixinKey, time.Unix(1702204169, 0))
+	if params.Get("w_rid") != "8f6f2b5b3d485fe1886cec6a0be8c5d4" {
+		t.Fatalf("unexpected w_rid after resign: %s", params.Get("w_rid"))
+	}
+}
+
+func serveFixture(t *testing.T, w http.ResponseWriter, name string) {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	keywords := []string{"robomaster"}
+	rules, err := compileRules(keywords, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Client{
+		client: resty.New().
+			SetBaseURL(srv.URL + "/x/").
+			AddRequestMiddleware(newWbiSigner().middleware),
+		keywords: keywords,
+		maxPages: 5,
+		rules:    rules,
+	}
+}
+
+func TestSearchVideosSigned(t *testing.T) {
+	navCalls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/x/web-interface/nav", func(w http.ResponseWriter, r *http.Request) {
+		navCalls++
+		serveFixture(t, w, "nav.json")
+	})
+	mux.HandleFunc("/x/web-interface/wbi/search/type", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		wRid := query.Get("w_rid")
+		if wRid == "" || query.Get("wts") == "" {
+			t.Errorf("request not signed: %s", r.URL.RawQuery)
+		}
+
+		wts, err := strconv.ParseInt(query.Get("wts"), 10, 64)
+		if err != nil {
+			t.Errorf("bad wts: %v", err)
+		}
+
+		sign(query, testMixinKey, time.Unix(wts, 0))
+		if query.Get("w_rid") != wRid {
+			t.Errorf("bad signature %s, want %s", wRid, query.Get("w_rid"))
+		}
+
+		serveFixture(t, w, "search.json")
+	})
+
+	client := newTestClient(t, mux)
+
+	for range 2 {
+		videos, err := client.SearchVideos("RoboMaster", time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(videos) != 3 {
+			t.Fatalf("expected 3 videos, got %d", len(videos))
+		}
+		if videos[0].Title != "**RoboMaster** 2026 机甲大师超级对抗赛 规则发布" {
+			t.Fatalf("unexpected title: %s", videos[0].Title)
+		}
+	}
+
+	if navCalls != 1 {
+		t.Fatalf("expected wbi keys to be cached, nav fetched %d times", navCalls)
+	}
+}
@@ -0,0 +1,353 @@
+package config
+
+import (
+	errors2 "errors"
+	"os"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/internal/job"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultPath is the config file read when CONFIG_FILE is not set. It is
+// optional, everything can still be configured with env variables.
+const DefaultPath = "config.yaml"
+
+// Config is the config file shared by the scan and rss commands. Env
+// variables override the values of the file.
+type Config struct {
+	DB        string      `yaml:"db"`
+	Scan      Scan        `yaml:"scan"`
+	Providers []*Provider `yaml:"providers"`
+	Consumers Consumers   `yaml:"consumers"`
+	Routes    []*Route    `yaml:"routes"`
+	RSS       RSS         `yaml:"rss"`
+
+	path string
+}
+
+type Scan struct {
+	Interval        time.Duration `yaml:"interval"`
+	Jitter          *float64      `yaml:"jitter"`
+	Lookback        time.Duration `yaml:"lookback"`
+	MaxCountPerPush int           `yaml:"max_count_per_push"`
+	MaxAttempts     int           `yaml:"max_attempts"`
+	NotifyUpdates   bool          `yaml:"notify_updates"`
+	MetricsAddr     string        `yaml:"metrics_addr"`
+}
+
+// Provider is an instance of a registered provider type. Config holds the
+// type's own config, decoded once the type is known.
+type Provider struct {
+	Name     string        `yaml:"name"`
+	Type     string        `yaml:"type"`
+	Interval time.Duration `yaml:"interval"`
+	Config   yaml.Node     `yaml:"config"`
+
+	line int
+}
+
+func (p *Provider) UnmarshalYAML(node *yaml.Node) error {
+	type provider Provider
+	if err := node.Decode((*provider)(p)); err != nil {
+		return err
+	}
+	p.line = node.Line
+
+	return nil
+}
+
+type Consumers struct {
+	Lark         *Lark          `yaml:"lark"`
+	LarkWebhooks []string       `yaml:"lark_webhooks"`
+	Destinations []*Destination `yaml:"destinations"`
+	// CardTemplate pushes cards filled from hosted templates instead of code
+	// built cards, omitted fields default to the templates rmtv used before.
+	CardTemplate *CardTemplate `yaml:"card_template"`
+}
+
+type CardTemplate struct {
+	Id            string `yaml:"id"`
+	IdNoImage     string `yaml:"id_no_image"`
+	ImageFallback string `yaml:"image_fallback"`
+}
+
+// Destination is a named consumer pushing to specific Lark chats or
+// webhooks, which routes refer to.
+type Destination struct {
+	Name         string   `yaml:"name"`
+	LarkChats    []string `yaml:"lark_chats"`
+	LarkWebhooks []string `yaml:"lark_webhooks"`
+
+	line int
+}
+
+func (d *Destination) UnmarshalYAML(node *yaml.Node) error {
+	type destination Destination
+	if err := node.Decode((*destination)(d)); err != nil {
+		return err
+	}
+	d.line = node.Line
+
+	return nil
+}
+
+// Route sends posts matching all of its criteria to the consumers in To,
+// either destinations or the lark and lark-webhook broadcast consumers.
+type Route struct {
+	Sources []string `yaml:"sources"`
+	Tags    []string `yaml:"tags"`
+	Authors []string `yaml:"authors"`
+	Titles  []string `yaml:"titles"`
+	To      []string `yaml:"to"`
+
+	line int
+}
+
+func (r *Route) UnmarshalYAML(node *yaml.Node) error {
+	type route Route
+	if err := node.Decode((*route)(r)); err != nil {
+		return err
+	}
+	r.line = node.Line
+
+	return nil
+}
+
+// Compile returns the route for the job.
+func (r *Route) Compile() (job.Route, error) {
+	var errs []error
+	titles := lo.FilterMap(r.Titles, func(item string, _ int) (*regexp.Regexp, bool) {
+		pattern, err := regexp.Compile(item)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid title pattern %q", item))
+		}
+		return pattern, err == nil
+	})
+	for _, pattern := range append(r.Sources, r.Authors...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, errors.Errorf("invalid pattern %q", pattern))
+		}
+	}
+
+	return job.Route{
+		Sources:   r.Sources,
+		Tags:      r.Tags,
+		Authors:   r.Authors,
+		Titles:    titles,
+		Consumers: r.To,
+	}, errors2.Join(errs...)
+}
+
+type Lark struct {
+	AppId     string `yaml:"app_id"`
+	AppSecret string `yaml:"app_secret"`
+	// VerificationToken and EncryptKey authenticate the event callback served
+	// by the rss command, see the event subscription page of the app.
+	VerificationToken string `yaml:"verification_token"`
+	EncryptKey        string `yaml:"encrypt_key"`
+	// DigestCount is the number of recent posts sent to a chat the bot is
+	// added to, 5 if unset.
+	DigestCount *int `yaml:"digest_count"`
+	// ImageCacheTTL is how long uploaded picture keys are reused, 7 days if
+	// unset.
+	ImageCacheTTL time.Duration `yaml:"image_cache_ttl"`
+}
+
+type RSS struct {
+	Addr     string `yaml:"addr"`
+	MaxItems int    `yaml:"max_items"`
+}
+
+func Default() *Config {
+	return &Config{
+		RSS: RSS{
+			Addr:     ":8080",
+			MaxItems: 10,
+		},
+	}
+}
+
+// Path returns CONFIG_FILE, or DefaultPath when it exists.
+func Path() string {
+	if path, ok := os.LookupEnv("CONFIG_FILE"); ok {
+		return path
+	}
+	if _, err := os.Stat(DefaultPath); err == nil {
+		return DefaultPath
+	}
+
+	return ""
+}
+
+// Load reads the config file at path, if any, and applies env overrides.
+// All errors found are returned at once, prefixed with their line.
+func Load(path string) (*Config, error) {
+	config := Default()
+	config.path = path
+
+	var errs []error
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read config")
+		}
+		errs = append(errs, config.parse(data))
+	}
+
+	errs = append(errs, config.applyEnv(), config.validate())
+
+	return config, errors2.Join(errs...)
+}
+
+func (c *Config) parse(data []byte) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return c.yamlError(err)
+	}
+	if root.Kind == 0 {
+		return nil
+	}
+
+	if err := c.interpolate(&root); err != nil {
+		return err
+	}
+
+	return c.decodeStrict(root.Content[0], c)
+}
+
+func (c *Config) validate() error {
+	var errs []error
+	for i, p := range c.Providers {
+		if p.Type == "" {
+			errs = append(errs, c.errorf(p.line, "provider %s has no type", p.Name))
+		}
+		if p.Name == "" {
+			p.Name = p.Type
+		}
+		if lo.ContainsBy(c.Providers[:i], func(item *Provider) bool {
+			return item.Name == p.Name
+		}) {
+			errs = append(errs, c.errorf(p.line, "provider %s is defined twice", p.Name))
+		}
+		if p.Interval < 0 {
+			errs = append(errs, c.errorf(p.line, "provider %s has a negative interval", p.Name))
+		}
+	}
+
+	if lark := c.Consumers.Lark; lark != nil && (lark.AppId == "" || lark.AppSecret == "") {
+		errs = append(errs, errors.New("consumers.lark needs app_id and app_secret"))
+	}
+	if lark := c.Consumers.Lark; lark != nil && lark.DigestCount != nil && *lark.DigestCount < 0 {
+		errs = append(errs, errors.New("consumers.lark.digest_count must not be negative"))
+	}
+	if lark := c.Consumers.Lark; lark != nil && lark.ImageCacheTTL < 0 {
+		errs = append(errs, errors.New("consumers.lark.image_cache_ttl must not be negative"))
+	}
+	consumers := []string{"lark", "lark-webhook"}
+	for _, d := range c.Consumers.Destinations {
+		switch {
+		case d.Name == "":
+			errs = append(errs, c.errorf(d.line, "destination has no name"))
+		case lo.Contains(consumers, d.Name):
+			errs = append(errs, c.errorf(d.line, "destination %s is defined twice", d.Name))
+		}
+		consumers = append(consumers, d.Name)
+
+		switch {
+		case len(d.LarkChats) > 0 && len(d.LarkWebhooks) > 0:
+			errs = append(errs, c.errorf(d.line, "destination %s has both lark_chats and lark_webhooks", d.Name))
+		case len(d.LarkChats) == 0 && len(d.LarkWebhooks) == 0:
+			errs = append(errs, c.errorf(d.line, "destination %s needs lark_chats or lark_webhooks", d.Name))
+		case len(d.LarkChats) > 0 && c.Consumers.Lark == nil:
+			errs = append(errs, c.errorf(d.line, "destination %s needs consumers.lark to push to chats", d.Name))
+		}
+	}
+	for _, r := range c.Routes {
+		if len(r.To) == 0 {
+			errs = append(errs, c.errorf(r.line, "route has no destination"))
+		}
+		for _, name := range r.To {
+			if !lo.Contains(consumers, name) {
+				errs = append(errs, c.errorf(r.line, "unknown destination %s", name))
+			}
+		}
+		if _, err := r.Compile(); err != nil {
+			errs = append(errs, c.errorf(r.line, "%v", err))
+		}
+	}
+	if c.Scan.Jitter != nil && (*c.Scan.Jitter < 0 || *c.Scan.Jitter >= 1) {
+		errs = append(errs, errors.Errorf("scan.jitter must be in [0, 1), got %v", *c.Scan.Jitter))
+	}
+	if c.Scan.MaxCountPerPush < 0 || c.Scan.MaxAttempts < 0 {
+		errs = append(errs, errors.New("scan.max_count_per_push and scan.max_attempts must not be negative"))
+	}
+	if c.RSS.MaxItems <= 0 {
+		errs = append(errs, errors.Errorf("rss.max_items must be positive, got %d", c.RSS.MaxItems))
+	}
+
+	return errors2.Join(errs...)
+}
+
+// Instances decodes, overrides with env variables and validates the config
+// of every provider, reporting all errors at once.
+func (c *Config) Instances() ([]*job.Instance, error) {
+	var errs []error
+	instances := make([]*job.Instance, 0, len(c.Providers))
+	for _, p := range c.Providers {
+		instance, err := job.NewInstance(p.Name, p.Type)
+		if err != nil {
+			errs = append(errs, c.errorf(p.line, "%v", err))
+			continue
+		}
+
+		if p.Config.Kind != 0 {
+			if err := c.decodeStrict(&p.Config, instance.Config); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+
+		if err := instance.LoadEnv(); err != nil {
+			errs = append(errs, err)
+		}
+		if err := instance.Validate(); err != nil {
+			errs = append(errs, c.errorf(p.line, "%v", err))
+		}
+		instances = append(instances, instance)
+	}
+
+	return instances, errors2.Join(errs...)
+}
+
+// RouteDestinations returns the compiled routes and the chats of each
+// destination.
+func (c *Config) RouteDestinations() ([]job.Route, map[string][]string, error) {
+	var errs []error
+	routes := lo.Map(c.Routes, func(item *Route, _ int) job.Route {
+		route, err := item.Compile()
+		errs = append(errs, err)
+		return route
+	})
+
+	destinations := lo.SliceToMap(c.Consumers.Destinations, func(item *Destination) (string, []string) {
+		return item.Name, item.LarkChats
+	})
+
+	return routes, destinations, errors2.Join(errs...)
+}
+
+// Check validates the core config and every provider.
+func (c *Config) Check() error {
+	var errs []error
+	if c.DB == "" {
+		errs = append(errs, errors.New("db is required"))
+	}
+
+	_, err := c.Instances()
+	return errors2.Join(append(errs, err)...)
+}
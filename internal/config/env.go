@@ -0,0 +1,132 @@
+package config
+
+import (
+	errors2 "errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// applyEnv overrides the config with the env variables used before the
+// config file existed, which keep working on their own.
+func (c *Config) applyEnv() error {
+	var errs []error
+	lookup := func(key string, parse func(string) error) {
+		if raw, ok := os.LookupEnv(key); ok {
+			if err := parse(strings.TrimSpace(raw)); err != nil {
+				errs = append(errs, errors.Wrapf(err, "invalid %s", key))
+			}
+		}
+	}
+	str := func(v *string) func(string) error {
+		return func(raw string) error {
+			*v = raw
+			return nil
+		}
+	}
+	integer := func(v *int) func(string) error {
+		return func(raw string) (err error) {
+			*v, err = strconv.Atoi(raw)
+			return
+		}
+	}
+	duration := func(v *time.Duration) func(string) error {
+		return func(raw string) (err error) {
+			*v, err = time.ParseDuration(raw)
+			return
+		}
+	}
+
+	lookup("DB_URL", str(&c.DB))
+
+	lookup("SCAN_INTERVAL", duration(&c.Scan.Interval))
+	lookup("SCAN_JITTER", func(raw string) error {
+		jitter, err := strconv.ParseFloat(raw, 64)
+		c.Scan.Jitter = &jitter
+		return err
+	})
+	lookup("SCAN_LOOKBACK", duration(&c.Scan.Lookback))
+	lookup("MAX_COUNT_PER_PUSH", integer(&c.Scan.MaxCountPerPush))
+	lookup("DELIVERY_MAX_ATTEMPTS", integer(&c.Scan.MaxAttempts))
+	lookup("NOTIFY_UPDATES", func(raw string) error {
+		c.Scan.NotifyUpdates = raw == "true"
+		return nil
+	})
+	lookup("METRICS_ADDR", str(&c.Scan.MetricsAddr))
+
+	// ENABLE_MODULES replaces the provider list, keeping the file's config of
+	// the instances it names.
+	if c.Providers == nil {
+		c.Providers = c.parseModules("bilibili,rmbbs,qflow")
+	}
+	lookup("ENABLE_MODULES", func(raw string) error {
+		c.Providers = c.parseModules(raw)
+		return nil
+	})
+	for _, p := range c.Providers {
+		name := p.Name
+		if name == "" {
+			name = p.Type
+		}
+		lookup(strings.ToUpper(strings.ReplaceAll(name, "-", "_"))+"_INTERVAL", duration(&p.Interval))
+	}
+
+	if _, ok := os.LookupEnv("LARK_APP_ID"); ok && c.Consumers.Lark == nil {
+		c.Consumers.Lark = &Lark{}
+	}
+	if lark := c.Consumers.Lark; lark != nil {
+		lookup("LARK_APP_ID", str(&lark.AppId))
+		lookup("LARK_APP_SECRET", str(&lark.AppSecret))
+		lookup("LARK_VERIFICATION_TOKEN", str(&lark.VerificationToken))
+		lookup("LARK_ENCRYPT_KEY", str(&lark.EncryptKey))
+		lookup("LARK_DIGEST_COUNT", func(raw string) error {
+			count, err := strconv.Atoi(raw)
+			lark.DigestCount = &count
+			return err
+		})
+		lookup("LARK_IMAGE_CACHE_TTL", duration(&lark.ImageCacheTTL))
+	}
+	for _, key := range []string{"LARK_CARD_TEMPLATE_ID", "LARK_CARD_TEMPLATE_ID_NO_IMAGE", "LARK_CARD_IMAGE_FALLBACK"} {
+		if _, ok := os.LookupEnv(key); ok && c.Consumers.CardTemplate == nil {
+			c.Consumers.CardTemplate = &CardTemplate{}
+		}
+	}
+	if t := c.Consumers.CardTemplate; t != nil {
+		lookup("LARK_CARD_TEMPLATE_ID", str(&t.Id))
+		lookup("LARK_CARD_TEMPLATE_ID_NO_IMAGE", str(&t.IdNoImage))
+		lookup("LARK_CARD_IMAGE_FALLBACK", str(&t.ImageFallback))
+	}
+	lookup("LARK_WEBHOOKS", func(raw string) error {
+		c.Consumers.LarkWebhooks = lo.Compact(strings.Split(raw, ","))
+		return nil
+	})
+
+	lookup("ADDR", str(&c.RSS.Addr))
+	lookup("MAX_FEED_ITEM_COUNT", integer(&c.RSS.MaxItems))
+
+	return errors2.Join(errs...)
+}
+
+func (c *Config) parseModules(raw string) []*Provider {
+	return lo.Map(lo.Compact(strings.Split(raw, ",")), func(item string, _ int) *Provider {
+		name, typeName, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok {
+			typeName = name
+		}
+
+		if p, ok := lo.Find(c.Providers, func(p *Provider) bool {
+			return p.Name == name || p.Name == "" && p.Type == name
+		}); ok {
+			return p
+		}
+
+		return &Provider{
+			Name: name,
+			Type: typeName,
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	errors2 "errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// errorf formats an error at line of the config file.
+func (c *Config) errorf(line int, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	if line == 0 {
+		return errors.New(msg)
+	}
+
+	return errors.Errorf("%s:%d: %s", c.path, line, msg)
+}
+
+var yamlLine = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// yamlError splits a yaml error into one error per problem, rewriting
+// "line N: msg" into "path:N: msg".
+func (c *Config) yamlError(err error) error {
+	var messages []string
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	} else {
+		messages = []string{err.Error()}
+	}
+
+	return errors2.Join(lo.Map(messages, func(item string, _ int) error {
+		if m := yamlLine.FindStringSubmatch(item); m != nil {
+			return errors.Errorf("%s:%s: %s", c.path, m[1], m[2])
+		}
+		return errors.Errorf("%s: %s", c.path, item)
+	})...)
+}
+
+var envRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// interpolate replaces ${VAR} and ${VAR:-default} in scalar values with env
+// variables, so that secrets need not be written into the file.
+func (c *Config) interpolate(node *yaml.Node) error {
+	var errs []error
+	if node.Kind == yaml.ScalarNode && strings.Contains(node.Value, "${") {
+		node.Value = envRef.ReplaceAllStringFunc(node.Value, func(ref string) string {
+			m := envRef.FindStringSubmatch(ref)
+			if value, ok := os.LookupEnv(m[1]); ok {
+				return value
+			}
+			if strings.Contains(ref, ":-") {
+				return m[2]
+			}
+			errs = append(errs, c.errorf(node.Line, "env variable %s is not set", m[1]))
+			return ""
+		})
+		// Plain values are resolved again, so that ${PORT} may be an int.
+		if node.Style == 0 {
+			node.Tag = ""
+		}
+	}
+
+	for _, child := range node.Content {
+		errs = append(errs, c.interpolate(child))
+	}
+
+	return errors2.Join(errs...)
+}
+
+// decodeStrict decodes node into v, also reporting every key that matches
+// no field.
+func (c *Config) decodeStrict(node *yaml.Node, v any) error {
+	errs := []error{c.checkFields(node, reflect.TypeOf(v))}
+	if err := node.Decode(v); err != nil {
+		errs = append(errs, c.yamlError(err))
+	}
+
+	return errors2.Join(errs...)
+}
+
+var nodeType = reflect.TypeOf(yaml.Node{})
+
+func (c *Config) checkFields(node *yaml.Node, t reflect.Type) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nodeType {
+		return nil
+	}
+
+	switch {
+	case t.Kind() == reflect.Slice && node.Kind == yaml.SequenceNode:
+		return errors2.Join(lo.Map(node.Content, func(item *yaml.Node, _ int) error {
+			return c.checkFields(item, t.Elem())
+		})...)
+	case t.Kind() != reflect.Struct:
+		return nil
+	case node.Kind != yaml.MappingNode:
+		return c.errorf(node.Line, "expected a mapping")
+	}
+
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if tag, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ","); tag != "" && tag != "-" {
+			fields[tag] = t.Field(i).Type
+		}
+	}
+
+	var errs []error
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		field, ok := fields[key.Value]
+		if !ok {
+			known := lo.Keys(fields)
+			slices.Sort(known)
+			errs = append(errs, c.errorf(key.Line, "unknown field %q, expected one of %s", key.Value, strings.Join(known, ", ")))
+			continue
+		}
+		errs = append(errs, c.checkFields(value, field))
+	}
+
+	return errors2.Join(errs...)
+}
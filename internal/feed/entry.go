@@ -0,0 +1,114 @@
+package feed
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/internal/job"
+	"github.com/wintbiit/rmtv/utils"
+)
+
+const (
+	excerptLength = 200
+	titleLength   = 50
+)
+
+type Entry struct {
+	feed *Feed
+
+	Guid       string
+	Title      string
+	Link       string
+	Summary    string
+	Content    string
+	Author     string
+	AuthorURL  string
+	Categories []string
+	Published  time.Time
+	Image      string
+}
+
+func (e *Entry) GetType() string {
+	if e.feed.Title != "" {
+		return e.feed.Title
+	}
+
+	return "订阅"
+}
+
+func (e *Entry) GetTypeColor() string {
+	return "indigo"
+}
+
+// GetId is derived from the feed URL and the entry's guid, since guids are
+// only unique within a feed.
+func (e *Entry) GetId() string {
+	sum := sha1.Sum([]byte(e.feed.URL + "\n" + e.Guid))
+	return "feed-" + hex.EncodeToString(sum[:])[:16]
+}
+
+func (e *Entry) GetPic() *string {
+	if e.Image == "" {
+		return nil
+	}
+
+	return &e.Image
+}
+
+// GetTitle falls back to an excerpt of the text, the link or the date for
+// untitled entries, which RSS and JSON Feed allow.
+func (e *Entry) GetTitle() string {
+	if title := strings.TrimSpace(utils.HTMLToText(e.Title)); title != "" {
+		return title
+	}
+
+	text := strings.Join(strings.Fields(utils.HTMLToText(lo.CoalesceOrEmpty(e.Summary, e.Content))), " ")
+	if text != "" {
+		return utils.Excerpt(text, titleLength)
+	}
+
+	return lo.CoalesceOrEmpty(e.Link, e.GetPubDate().Format(time.DateTime))
+}
+
+func (e *Entry) GetDesc() string {
+	return utils.Excerpt(utils.HTMLToText(lo.CoalesceOrEmpty(e.Summary, e.Content)), excerptLength)
+}
+
+func (e *Entry) GetContent() string {
+	return utils.SanitizeHTML(e.Content)
+}
+
+func (e *Entry) GetTags() []string {
+	return lo.Compact(lo.Map(e.Categories, func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	}))
+}
+
+// GetPubDate falls back to the time the feed was fetched for undated
+// entries.
+func (e *Entry) GetPubDate() time.Time {
+	if e.Published.IsZero() {
+		return e.feed.FetchedAt
+	}
+
+	return e.Published
+}
+
+func (e *Entry) GetAuthor() string {
+	return lo.CoalesceOrEmpty(e.Author, e.feed.Title)
+}
+
+func (e *Entry) GetAuthorUrl() string {
+	return lo.CoalesceOrEmpty(e.AuthorURL, e.feed.Link)
+}
+
+func (e *Entry) GetUrl() string {
+	return e.Link
+}
+
+func (e *Entry) GetExtra() job.PostExtra {
+	return nil
+}
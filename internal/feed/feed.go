@@ -0,0 +1,210 @@
+package feed
+
+import (
+	"context"
+	errors2 "errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/internal/job"
+	"github.com/wintbiit/rmtv/utils"
+	"resty.dev/v3"
+)
+
+const UA = "Mozilla/5.0 (compatible; rmtv; +https://github.com/wintbiit/rmtv)"
+
+const Module = "feed"
+
+type Client struct {
+	name   string
+	client *resty.Client
+	urls   []string
+	store  *job.Store
+	states map[string]*feedState
+	// pending holds the validators of fetched feeds until their entries are
+	// stored.
+	pending map[string]*feedState
+}
+
+func init() {
+	job.Register(Module, "FEED_", "RSS、Atom、JSON Feed 订阅", DefaultConfig, (*Config).Validate, func(name string, config *Config) (job.MessageProvider, error) {
+		return NewClient(name, config)
+	})
+}
+
+func (c *Client) Name() string {
+	return c.name
+}
+
+func (c *Client) SetStore(store *job.Store) {
+	c.store = store
+}
+
+type Config struct {
+	Urls []string `yaml:"urls" env:"URLS"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	if len(c.Urls) == 0 {
+		errs = append(errs, errors.New("urls is required"))
+	}
+	for _, raw := range c.Urls {
+		if u, err := url.Parse(raw); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			errs = append(errs, errors.Errorf("invalid feed url %q", raw))
+		}
+	}
+
+	return errors2.Join(errs...)
+}
+
+func NewClient(name string, config *Config) (*Client, error) {
+	c := resty.New().
+		SetRetryCount(3).
+		SetRetryMaxWaitTime(5*1000).
+		SetRetryWaitTime(1*1000).
+		SetTimeout(30*time.Second).
+		SetHeader("User-Agent", UA).
+		SetHeader("Accept", "application/rss+xml, application/atom+xml, application/feed+json, application/xml;q=0.9, */*;q=0.8").
+		SetDebug(utils.Debug)
+
+	logrus.Infof("Initialized feed client %s with feeds: %v", name, config.Urls)
+
+	return &Client{
+		name:    name,
+		client:  c,
+		urls:    config.Urls,
+		states:  make(map[string]*feedState),
+		pending: make(map[string]*feedState),
+	}, nil
+}
+
+// feedState holds the validators of the last fetch of a feed, persisted so
+// that unchanged feeds are not downloaded again after a restart.
+type feedState struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+func (c *Client) stateKey(url string) string {
+	return c.name + ":" + url
+}
+
+func (c *Client) loadState(ctx context.Context, url string) (*feedState, error) {
+	if state, ok := c.states[url]; ok {
+		return state, nil
+	}
+
+	state := &feedState{}
+	if c.store != nil {
+		if _, err := c.store.Get(ctx, c.stateKey(url), state); err != nil {
+			return nil, err
+		}
+	}
+
+	c.states[url] = state
+	return state, nil
+}
+
+func (c *Client) saveState(ctx context.Context, url string, state *feedState) error {
+	c.states[url] = state
+	if c.store == nil {
+		return nil
+	}
+
+	return c.store.Put(ctx, c.stateKey(url), state)
+}
+
+// Fetch downloads and parses the feed at url. It returns nil without error
+// when the feed has not changed since the last committed fetch.
+func (c *Client) Fetch(ctx context.Context, url string) (*Feed, error) {
+	state, err := c.loadState(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	req := c.client.R().SetContext(ctx)
+	if state.ETag != "" {
+		req.SetHeader("If-None-Match", state.ETag)
+	}
+	if state.LastModified != "" {
+		req.SetHeader("If-Modified-Since", state.LastModified)
+	}
+
+	resp, err := req.Get(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch feed error")
+	}
+
+	if resp.StatusCode() == http.StatusNotModified {
+		logrus.Debugf("Feed %s not modified", url)
+		return nil, nil
+	}
+
+	if !resp.IsSuccess() {
+		return nil, errors.Errorf("fetch feed failed: %d", resp.StatusCode())
+	}
+
+	feed, err := Parse(resp.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	feed.URL = url
+	feed.FetchedAt = time.Now()
+
+	c.pending[url] = &feedState{
+		ETag:         resp.Header().Get("ETag"),
+		LastModified: resp.Header().Get("Last-Modified"),
+	}
+
+	return feed, nil
+}
+
+// Commit saves the validators of the feeds fetched since the last commit,
+// once their entries are stored.
+func (c *Client) Commit(ctx context.Context) error {
+	for url, state := range c.pending {
+		if err := c.saveState(ctx, url, state); err != nil {
+			return err
+		}
+		delete(c.pending, url)
+	}
+
+	return nil
+}
+
+// Collect fetches every feed. Feeds that fail are skipped and reported in a
+// job.PartialError.
+func (c *Client) Collect() ([]job.Post, error) {
+	ctx := context.Background()
+	results := make([]job.Post, 0)
+	var errs []error
+
+	for _, url := range c.urls {
+		feed, err := c.Fetch(ctx, url)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "feed %s", url))
+			continue
+		}
+		if feed == nil {
+			continue
+		}
+
+		for _, entry := range feed.Entries {
+			results = append(results, entry)
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, &job.PartialError{Errs: errs}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,185 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/internal/job"
+	"resty.dev/v3"
+)
+
+func newTestClient(urls ...string) *Client {
+	return &Client{
+		name:    Module,
+		client:  resty.New(),
+		urls:    urls,
+		states:  make(map[string]*feedState),
+		pending: make(map[string]*feedState),
+	}
+}
+
+func serveFeeds(t *testing.T) (*httptest.Server, map[string]int) {
+	hits := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits[r.URL.Path]++
+
+		etag := `"` + r.URL.Path + `-v1"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		data, err := os.ReadFile(filepath.Join("testdata", filepath.Base(r.URL.Path)))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", "Wed, 05 Mar 2025 04:00:00 GMT")
+		w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, hits
+}
+
+func TestCollect(t *testing.T) {
+	srv, _ := serveFeeds(t)
+	client := newTestClient(srv.URL+"/rss.xml", srv.URL+"/atom.xml", srv.URL+"/feed.json", srv.URL+"/missing.xml")
+
+	// The missing feed is skipped, the others are still collected.
+	posts, err := client.Collect()
+	var partial *job.PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(partial.Errs) != 1 || !strings.Contains(partial.Errs[0].Error(), "/missing.xml: fetch feed failed: 404") {
+		t.Fatalf("unexpected skipped feeds: %v", partial.Errs)
+	}
+
+	if len(posts) != 4 {
+		t.Fatalf("unexpected post count: %d", len(posts))
+	}
+
+	rss := posts[0].(*Entry)
+	if rss.GetType() != "RoboMaster 公告" || rss.GetAuthor() != "RoboMaster 组委会" {
+		t.Fatalf("unexpected rss entry: %+v", rss)
+	}
+	if pic := rss.GetPic(); pic == nil || *pic != "https://example.com/cover.jpg" {
+		t.Fatalf("unexpected rss picture: %v", pic)
+	}
+	if rss.GetDesc() != "规则手册更新说明" {
+		t.Fatalf("unexpected rss description: %q", rss.GetDesc())
+	}
+	if rss.GetContent() != `<p>规则手册更新说明</p><img src="https://example.com/rules.png">` {
+		t.Fatalf("unexpected rss content: %q", rss.GetContent())
+	}
+	if !rss.GetPubDate().Equal(time.Date(2025, 3, 5, 4, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected rss pub date: %v", rss.GetPubDate())
+	}
+
+	// Entries without guid fall back to their link, non-image enclosures are
+	// not pictures.
+	untitled := posts[1].(*Entry)
+	if untitled.Guid != untitled.Link || untitled.GetPic() != nil {
+		t.Fatalf("unexpected rss entry: %+v", untitled)
+	}
+	if untitled.GetId() == rss.GetId() {
+		t.Fatal("rss entries share an id")
+	}
+
+	atom := posts[2].(*Entry)
+	if atom.GetUrl() != "https://github.com/example/rm-vision/releases/tag/v1.2.0" || atom.GetAuthorUrl() != "https://github.com/example" {
+		t.Fatalf("unexpected atom entry: %+v", atom)
+	}
+	if atom.GetDesc() != "新增能量机关识别" || atom.GetTags()[0] != "release" {
+		t.Fatalf("unexpected atom entry: %+v", atom)
+	}
+	if pic := atom.GetPic(); pic == nil || *pic != "https://example.com/avatar.png" {
+		t.Fatalf("unexpected atom picture: %v", pic)
+	}
+
+	json := posts[3].(*Entry)
+	if json.GetAuthor() != "视觉组" || json.GetContent() != "<p>第一段<br>第二段</p>" {
+		t.Fatalf("unexpected json feed entry: %+v", json)
+	}
+	if pic := json.GetPic(); pic == nil || *pic != "https://blog.example.com/armor.png" {
+		t.Fatalf("unexpected json feed picture: %v", pic)
+	}
+}
+
+func TestConditionalFetch(t *testing.T) {
+	srv, hits := serveFeeds(t)
+	client := newTestClient(srv.URL + "/rss.xml")
+
+	feed, err := client.Fetch(context.Background(), srv.URL+"/rss.xml")
+	if err != nil || feed == nil {
+		t.Fatalf("unexpected first fetch: %v %v", feed, err)
+	}
+
+	// Until the entries are stored the feed is downloaded again.
+	if feed, err := client.Fetch(context.Background(), srv.URL+"/rss.xml"); err != nil || feed == nil {
+		t.Fatalf("uncommitted feed was not fetched again: %v %v", feed, err)
+	}
+	if err := client.Commit(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	state := client.states[srv.URL+"/rss.xml"]
+	if state.ETag != `"/rss.xml-v1"` || state.LastModified == "" {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+
+	posts, err := client.Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 0 || hits["/rss.xml"] != 3 {
+		t.Fatalf("unmodified feed returned %d posts after %d requests", len(posts), hits["/rss.xml"])
+	}
+}
+
+func TestParseUnknown(t *testing.T) {
+	if _, err := Parse([]byte(`<html><body></body></html>`)); err == nil {
+		t.Fatal("html is parsed as a feed")
+	}
+	if _, err := Parse([]byte(`{"title": "not a feed"}`)); err == nil {
+		t.Fatal("json without version is parsed as a feed")
+	}
+}
+
+func TestParseUntitled(t *testing.T) {
+	feed, err := Parse([]byte(`<rss version="2.0"><channel><title>动态</title>
+<item><description>&lt;p&gt;今天的   训练赛结束了&lt;/p&gt;</description></item>
+<item><description>第二条动态</description></item>
+<item><link>https://example.com/3</link></item>
+</channel></rss>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	feed.URL = "https://example.com/feed.xml"
+
+	titles := lo.Map(feed.Entries, func(item *Entry, _ int) string {
+		return item.GetTitle()
+	})
+	if !slices.Equal(titles, []string{"今天的 训练赛结束了", "第二条动态", "https://example.com/3"}) {
+		t.Fatalf("unexpected titles: %q", titles)
+	}
+
+	ids := lo.Uniq(lo.Map(feed.Entries, func(item *Entry, _ int) string {
+		return item.GetId()
+	}))
+	if len(ids) != 3 {
+		t.Fatalf("entries without guid share ids: %v", ids)
+	}
+}
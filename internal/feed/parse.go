@@ -0,0 +1,326 @@
+package feed
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// Feed is a parsed RSS 2.0, Atom or JSON Feed document.
+type Feed struct {
+	Title     string
+	Link      string
+	URL       string
+	FetchedAt time.Time
+	Entries   []*Entry
+}
+
+type rssDocument struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Link  string    `xml:"link"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type rssItem struct {
+	Guid        string         `xml:"guid"`
+	Title       string         `xml:"title"`
+	Link        string         `xml:"link"`
+	Description string         `xml:"description"`
+	Content     string         `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Author      string         `xml:"author"`
+	Creator     string         `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Categories  []string       `xml:"category"`
+	PubDate     string         `xml:"pubDate"`
+	Enclosures  []rssEnclosure `xml:"enclosure"`
+	Thumbnail   struct {
+		URL string `xml:"url,attr"`
+	} `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+}
+
+type rssEnclosure struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri"`
+}
+
+type atomDocument struct {
+	Title   string      `xml:"title"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomPerson  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Links      []atomLink     `xml:"link"`
+	Summary    string         `xml:"summary"`
+	Content    string         `xml:"content"`
+	Author     atomPerson     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Thumbnail  struct {
+		URL string `xml:"url,attr"`
+	} `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type jsonFeedDocument struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	HomePageURL string           `json:"home_page_url"`
+	Authors     []jsonFeedAuthor `json:"authors"`
+	Items       []struct {
+		ID            string           `json:"id"`
+		URL           string           `json:"url"`
+		Title         string           `json:"title"`
+		ContentHTML   string           `json:"content_html"`
+		ContentText   string           `json:"content_text"`
+		Summary       string           `json:"summary"`
+		Image         string           `json:"image"`
+		BannerImage   string           `json:"banner_image"`
+		DatePublished string           `json:"date_published"`
+		DateModified  string           `json:"date_modified"`
+		Authors       []jsonFeedAuthor `json:"authors"`
+		Tags          []string         `json:"tags"`
+		Attachments   []struct {
+			URL      string `json:"url"`
+			MimeType string `json:"mime_type"`
+		} `json:"attachments"`
+	} `json:"items"`
+}
+
+// Parse detects the format of a feed document and parses it.
+func Parse(data []byte) (*Feed, error) {
+	feed, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range feed.Entries {
+		if entry.Guid == "" {
+			entry.Guid = contentGuid(entry)
+		}
+	}
+
+	return feed, nil
+}
+
+// contentGuid identifies entries without guid nor link by their content.
+func contentGuid(e *Entry) string {
+	sum := sha1.Sum([]byte(strings.Join([]string{e.Title, e.Summary, e.Content, e.Published.Format(time.RFC3339)}, "\n")))
+	return "sha1:" + hex.EncodeToString(sum[:])
+}
+
+func parse(data []byte) (*Feed, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("{")) {
+		return parseJSONFeed(data)
+	}
+
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, errors.Wrap(err, "failed to parse feed")
+	}
+
+	switch root.XMLName.Local {
+	case "rss":
+		return parseRSS(data)
+	case "feed":
+		return parseAtom(data)
+	default:
+		return nil, errors.Errorf("unknown feed format <%s>", root.XMLName.Local)
+	}
+}
+
+func parseRSS(data []byte) (*Feed, error) {
+	var doc rssDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Wrap(err, "failed to parse rss")
+	}
+
+	feed := &Feed{
+		Title: strings.TrimSpace(doc.Channel.Title),
+		Link:  strings.TrimSpace(doc.Channel.Link),
+	}
+	for _, item := range doc.Channel.Items {
+		pic := item.Thumbnail.URL
+		if enclosure, ok := lo.Find(item.Enclosures, func(e rssEnclosure) bool {
+			return strings.HasPrefix(e.Type, "image/")
+		}); ok {
+			pic = enclosure.URL
+		}
+
+		feed.Entries = append(feed.Entries, &Entry{
+			feed:       feed,
+			Guid:       lo.CoalesceOrEmpty(item.Guid, item.Link),
+			Title:      item.Title,
+			Link:       item.Link,
+			Summary:    item.Description,
+			Content:    item.Content,
+			Author:     lo.CoalesceOrEmpty(item.Creator, item.Author),
+			Categories: item.Categories,
+			Published:  parseTime(item.PubDate),
+			Image:      pic,
+		})
+	}
+
+	return feed, nil
+}
+
+func atomHref(links []atomLink, rel string) string {
+	link, ok := lo.Find(links, func(item atomLink) bool {
+		return item.Rel == rel || rel == "alternate" && item.Rel == ""
+	})
+	if !ok {
+		return ""
+	}
+
+	return link.Href
+}
+
+func parseAtom(data []byte) (*Feed, error) {
+	var doc atomDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Wrap(err, "failed to parse atom")
+	}
+
+	feed := &Feed{
+		Title: strings.TrimSpace(doc.Title),
+		Link:  atomHref(doc.Links, "alternate"),
+	}
+	for _, entry := range doc.Entries {
+		pic := entry.Thumbnail.URL
+		if enclosure, ok := lo.Find(entry.Links, func(item atomLink) bool {
+			return item.Rel == "enclosure" && strings.HasPrefix(item.Type, "image/")
+		}); ok {
+			pic = enclosure.Href
+		}
+
+		author := entry.Author
+		if author.Name == "" {
+			author = doc.Author
+		}
+
+		feed.Entries = append(feed.Entries, &Entry{
+			feed:      feed,
+			Guid:      lo.CoalesceOrEmpty(entry.ID, atomHref(entry.Links, "alternate")),
+			Title:     entry.Title,
+			Link:      atomHref(entry.Links, "alternate"),
+			Summary:   entry.Summary,
+			Content:   entry.Content,
+			Author:    author.Name,
+			AuthorURL: author.URI,
+			Categories: lo.Map(entry.Categories, func(item atomCategory, _ int) string {
+				return item.Term
+			}),
+			Published: parseTime(lo.CoalesceOrEmpty(entry.Published, entry.Updated)),
+			Image:     pic,
+		})
+	}
+
+	return feed, nil
+}
+
+func parseJSONFeed(data []byte) (*Feed, error) {
+	var doc jsonFeedDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Wrap(err, "failed to parse json feed")
+	}
+
+	if !strings.HasPrefix(doc.Version, "https://jsonfeed.org/version/") {
+		return nil, errors.Errorf("unknown json feed version %q", doc.Version)
+	}
+
+	feed := &Feed{
+		Title: strings.TrimSpace(doc.Title),
+		Link:  doc.HomePageURL,
+	}
+	for _, item := range doc.Items {
+		pic := lo.CoalesceOrEmpty(item.Image, item.BannerImage)
+		for _, attachment := range item.Attachments {
+			if pic == "" && strings.HasPrefix(attachment.MimeType, "image/") {
+				pic = attachment.URL
+			}
+		}
+
+		authors := lo.CoalesceSliceOrEmpty(item.Authors, doc.Authors)
+		author, _ := lo.First(authors)
+
+		content := item.ContentHTML
+		if content == "" && item.ContentText != "" {
+			content = "<p>" + strings.ReplaceAll(html.EscapeString(item.ContentText), "\n", "<br>") + "</p>"
+		}
+
+		feed.Entries = append(feed.Entries, &Entry{
+			feed:       feed,
+			Guid:       lo.CoalesceOrEmpty(item.ID, item.URL),
+			Title:      item.Title,
+			Link:       item.URL,
+			Summary:    item.Summary,
+			Content:    content,
+			Author:     author.Name,
+			AuthorURL:  author.URL,
+			Categories: item.Tags,
+			Published:  parseTime(lo.CoalesceOrEmpty(item.DatePublished, item.DateModified)),
+			Image:      pic,
+		})
+	}
+
+	return feed, nil
+}
+
+var timeLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	time.RFC3339,
+	time.RFC3339Nano,
+	time.DateTime,
+}
+
+// parseTime reads the date formats found in the wild, returning the zero
+// time when none matches.
+func parseTime(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
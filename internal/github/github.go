@@ -0,0 +1,261 @@
+package github
+
+import (
+	"context"
+	errors2 "errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/internal/job"
+	"github.com/wintbiit/rmtv/utils"
+	"resty.dev/v3"
+)
+
+const UA = "rmtv (+https://github.com/wintbiit/rmtv)"
+
+const Module = "github"
+
+type Client struct {
+	name     string
+	client   *resty.Client
+	repos    []string
+	tags     bool
+	topic    string
+	minStars int
+	store    *job.Store
+	seen     map[string][]string
+	// pending holds the keys seen by the last collect until its posts are
+	// stored.
+	pending map[string][]string
+}
+
+func init() {
+	job.Register(Module, "GITHUB_", "GitHub 仓库发布、标签与主题仓库", DefaultConfig, (*Config).Validate, func(name string, config *Config) (job.MessageProvider, error) {
+		return NewClient(name, config)
+	})
+}
+
+func (c *Client) Name() string {
+	return c.name
+}
+
+func (c *Client) SetStore(store *job.Store) {
+	c.store = store
+}
+
+type Config struct {
+	Token    string   `yaml:"token" env:"TOKEN"`
+	Repos    []string `yaml:"repos" env:"REPOS"`
+	Tags     bool     `yaml:"tags" env:"TAGS"`
+	Topic    string   `yaml:"topic" env:"TOPIC"`
+	MinStars int      `yaml:"min_stars" env:"MIN_STARS"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		MinStars: 50,
+	}
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	if len(c.Repos) == 0 && c.Topic == "" {
+		errs = append(errs, errors.New("repos or topic is required"))
+	}
+	for _, repo := range c.Repos {
+		if owner, name, ok := strings.Cut(repo, "/"); !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+			errs = append(errs, errors.Errorf("invalid repository %q", repo))
+		}
+	}
+	if c.MinStars < 0 {
+		errs = append(errs, errors.Errorf("invalid min stars %d", c.MinStars))
+	}
+
+	return errors2.Join(errs...)
+}
+
+func NewClient(name string, config *Config) (*Client, error) {
+	limits := newRateLimits()
+	c := resty.New().
+		SetBaseURL("https://api.github.com/").
+		SetRetryCount(3).
+		SetRetryMaxWaitTime(5*1000).
+		SetRetryWaitTime(1*1000).
+		SetHeader("User-Agent", UA).
+		SetHeader("Accept", "application/vnd.github.full+json").
+		SetHeader("X-GitHub-Api-Version", "2022-11-28").
+		SetDebug(utils.Debug).
+		AddRequestMiddleware(limits.before).
+		AddResponseMiddleware(limits.after)
+
+	if config.Token != "" {
+		c.SetAuthToken(config.Token)
+	}
+
+	logrus.Infof("Initialized GitHub client %s with repositories: %v, topic: %s", name, config.Repos, config.Topic)
+
+	return &Client{
+		name:     name,
+		client:   c,
+		repos:    config.Repos,
+		tags:     config.Tags,
+		topic:    config.Topic,
+		minStars: config.MinStars,
+		seen:     make(map[string][]string),
+		pending:  make(map[string][]string),
+	}, nil
+}
+
+// rateLimits follows the X-RateLimit headers of each API resource and fails
+// requests early while a resource is exhausted, instead of burning the
+// remaining quota of the token on errors.
+type rateLimits struct {
+	mu     sync.Mutex
+	resets map[string]time.Time
+}
+
+func newRateLimits() *rateLimits {
+	return &rateLimits{resets: make(map[string]time.Time)}
+}
+
+func resource(path string) string {
+	if strings.HasPrefix(strings.TrimPrefix(path, "/"), "search/") {
+		return "search"
+	}
+
+	return "core"
+}
+
+func (r *rateLimits) before(_ *resty.Client, req *resty.Request) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := resource(req.URL)
+	if reset, ok := r.resets[name]; ok && time.Now().Before(reset) {
+		return errors.Errorf("github %s rate limit exceeded until %s", name, reset.Format(time.DateTime))
+	}
+
+	return nil
+}
+
+func (r *rateLimits) after(_ *resty.Client, resp *resty.Response) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	header := resp.Header()
+	name := header.Get("X-RateLimit-Resource")
+	if name == "" {
+		name = resource(resp.Request.URL)
+	}
+
+	switch {
+	case header.Get("Retry-After") != "":
+		seconds, _ := strconv.Atoi(header.Get("Retry-After"))
+		r.resets[name] = time.Now().Add(time.Duration(seconds) * time.Second)
+	case header.Get("X-RateLimit-Remaining") == "0":
+		reset, _ := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+		r.resets[name] = time.Unix(reset, 0)
+	default:
+		delete(r.resets, name)
+	}
+
+	if reset, ok := r.resets[name]; ok {
+		logrus.Warnf("GitHub %s rate limit exceeded until %s", name, reset.Format(time.DateTime))
+	}
+
+	return nil
+}
+
+// unseen returns the keys not seen under key before. Nothing is new when key
+// has never been seen, so that enabling a repository does not announce its
+// whole history.
+func (c *Client) unseen(ctx context.Context, key string, keys []string) ([]string, error) {
+	seen, ok := c.seen[key]
+	if !ok && c.store != nil {
+		var err error
+		if ok, err = c.store.Get(ctx, key, &seen); err != nil {
+			return nil, err
+		}
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	c.seen[key] = seen
+	return lo.Without(keys, seen...), nil
+}
+
+// markSeen remembers keys under key once the posts of the current collect
+// are stored.
+func (c *Client) markSeen(key string, keys []string) {
+	c.pending[key] = lo.Uniq(append(c.seen[key], keys...))
+}
+
+// Commit saves the keys seen by the last collect, once its posts are stored.
+func (c *Client) Commit(ctx context.Context) error {
+	for key, seen := range c.pending {
+		if c.store != nil {
+			if err := c.store.Put(ctx, key, seen); err != nil {
+				return err
+			}
+		}
+		c.seen[key] = seen
+		delete(c.pending, key)
+	}
+
+	return nil
+}
+
+// Collect lists the releases and tags of every repo and the repos of the
+// topic. Requests that fail are skipped and reported in a job.PartialError.
+func (c *Client) Collect() ([]job.Post, error) {
+	ctx := context.Background()
+	results := make([]job.Post, 0)
+	var errs []error
+	clear(c.pending)
+
+	for _, repo := range c.repos {
+		releases, err := c.ListReleases(repo)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "releases of %s", repo))
+			continue
+		}
+		for _, release := range releases {
+			results = append(results, release)
+		}
+
+		if !c.tags {
+			continue
+		}
+
+		tags, err := c.NewTags(ctx, repo, releases)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "tags of %s", repo))
+			continue
+		}
+		for _, tag := range tags {
+			results = append(results, tag)
+		}
+	}
+
+	if c.topic != "" {
+		repos, err := c.NewTopicRepos(ctx)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "topic %s", c.topic))
+		}
+		for _, repo := range repos {
+			results = append(results, repo)
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, &job.PartialError{Errs: errs}
+	}
+
+	return results, nil
+}
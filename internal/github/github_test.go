@@ -0,0 +1,235 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wintbiit/rmtv/internal/job"
+	"resty.dev/v3"
+)
+
+func serveFixture(t *testing.T, w http.ResponseWriter, name string) {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	limits := newRateLimits()
+	return &Client{
+		name: Module,
+		client: resty.New().
+			SetBaseURL(srv.URL + "/").
+			AddRequestMiddleware(limits.before).
+			AddResponseMiddleware(limits.after),
+		repos:    []string{"example/rm-vision"},
+		minStars: 50,
+		seen:     make(map[string][]string),
+		pending:  make(map[string][]string),
+	}
+}
+
+func collectAndCommit(t *testing.T, client *Client) []job.Post {
+	posts, err := client.Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Commit(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return posts
+}
+
+func TestCollectReleases(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/example/rm-vision/releases", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("per_page") != "10" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		serveFixture(t, w, "releases.json")
+	})
+	client := newTestClient(t, mux)
+
+	posts, err := client.Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Drafts are not announced.
+	if len(posts) != 1 {
+		t.Fatalf("unexpected post count: %d", len(posts))
+	}
+
+	release := posts[0].(*Release)
+	if release.GetId() != "github-release-2001" || release.GetTitle() != "example/rm-vision v1.2.0 能量机关" {
+		t.Fatalf("unexpected release: %+v", release)
+	}
+	if release.GetDesc() != "更新\n- 新增能量机关识别" || release.GetContent() != "<h2>更新</h2><ul><li>新增能量机关识别</li></ul>" {
+		t.Fatalf("unexpected release notes: %q %q", release.GetDesc(), release.GetContent())
+	}
+	if !release.GetPubDate().Equal(time.Date(2025, 3, 5, 4, 0, 0, 0, time.UTC)) || release.GetAuthor() != "octo" {
+		t.Fatalf("unexpected release: %+v", release)
+	}
+}
+
+func TestCollectSkipsFailingRepos(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/example/rm-vision/releases", func(w http.ResponseWriter, r *http.Request) {
+		serveFixture(t, w, "releases.json")
+	})
+	client := newTestClient(t, mux)
+	client.repos = []string{"example/missing", "example/rm-vision"}
+
+	// The missing repo is skipped, the releases of the other are still
+	// collected.
+	posts, err := client.Collect()
+	var partial *job.PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(partial.Errs) != 1 || !strings.HasPrefix(partial.Errs[0].Error(), "releases of example/missing") {
+		t.Fatalf("unexpected skipped repos: %v", partial.Errs)
+	}
+	if len(posts) != 1 || posts[0].GetId() != "github-release-2001" {
+		t.Fatalf("unexpected posts: %v", posts)
+	}
+}
+
+func TestNewTags(t *testing.T) {
+	scans := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/example/rm-vision/releases", func(w http.ResponseWriter, r *http.Request) {
+		scans++
+		serveFixture(t, w, "releases.json")
+	})
+	mux.HandleFunc("/repos/example/rm-vision/tags", func(w http.ResponseWriter, r *http.Request) {
+		serveFixture(t, w, fmt.Sprintf("tags_%d.json", scans))
+	})
+	mux.HandleFunc("/repos/example/rm-vision/commits/cccccccccccccccccccccccccccccccccccccccc", func(w http.ResponseWriter, r *http.Request) {
+		serveFixture(t, w, "commit.json")
+	})
+	client := newTestClient(t, mux)
+	client.tags = true
+
+	// The first scan only remembers the existing tags.
+	if posts := collectAndCommit(t, client); len(posts) != 1 {
+		t.Fatalf("unexpected post count of first scan: %d", len(posts))
+	}
+
+	posts := collectAndCommit(t, client)
+	if len(posts) != 2 {
+		t.Fatalf("unexpected post count of second scan: %d", len(posts))
+	}
+
+	tag := posts[1].(*Tag)
+	if tag.GetTitle() != "example/rm-vision v1.2.1" || tag.GetDesc() != "新标签 v1.2.1，提交 ccccccc" {
+		t.Fatalf("unexpected tag: %+v", tag)
+	}
+	if !tag.GetPubDate().Equal(time.Date(2025, 3, 6, 2, 0, 0, 0, time.UTC)) || tag.GetAuthorUrl() != "https://github.com/octo" {
+		t.Fatalf("unexpected tag: %+v", tag)
+	}
+}
+
+func TestNewTopicRepos(t *testing.T) {
+	scans := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") != "topic:robomaster stars:>=50" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		scans++
+		serveFixture(t, w, fmt.Sprintf("search_%d.json", scans))
+	})
+	client := newTestClient(t, mux)
+	client.repos = nil
+	client.topic = "robomaster"
+
+	if posts := collectAndCommit(t, client); len(posts) != 0 {
+		t.Fatalf("unexpected first scan: %d posts", len(posts))
+	}
+
+	posts := collectAndCommit(t, client)
+	if len(posts) != 1 || posts[0].GetTitle() != "team/rm-nav" || posts[0].GetPubDate().IsZero() {
+		t.Fatalf("unexpected second scan: %+v", posts)
+	}
+}
+
+func TestNewTagsUntilStored(t *testing.T) {
+	commitFails := true
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/example/rm-vision/releases", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	})
+	mux.HandleFunc("/repos/example/rm-vision/tags", func(w http.ResponseWriter, r *http.Request) {
+		serveFixture(t, w, "tags_2.json")
+	})
+	mux.HandleFunc("/repos/example/rm-vision/commits/cccccccccccccccccccccccccccccccccccccccc", func(w http.ResponseWriter, r *http.Request) {
+		if commitFails {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		serveFixture(t, w, "commit.json")
+	})
+	client := newTestClient(t, mux)
+	client.tags = true
+	client.seen["github:tags:example/rm-vision"] = []string{"v1.2.0", "v1.1.0"}
+
+	// The tag whose commit failed is not remembered.
+	if posts := collectAndCommit(t, client); len(posts) != 0 {
+		t.Fatalf("unexpected posts with a failing commit: %d", len(posts))
+	}
+
+	// Posts that were not stored are collected again.
+	commitFails = false
+	if posts, err := client.Collect(); err != nil || len(posts) != 1 {
+		t.Fatalf("unexpected scan: %d posts, %v", len(posts), err)
+	}
+	if posts := collectAndCommit(t, client); len(posts) != 1 || posts[0].GetId() != "github-tag-example/rm-vision-v1.2.1" {
+		t.Fatalf("unexpected scan after a failed store: %+v", posts)
+	}
+
+	if posts := collectAndCommit(t, client); len(posts) != 0 {
+		t.Fatalf("tag announced again: %+v", posts)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/example/rm-vision/releases", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-RateLimit-Resource", "core")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		serveFixture(t, w, "releases.json")
+	})
+	client := newTestClient(t, mux)
+
+	if _, err := client.ListReleases("example/rm-vision"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ListReleases("example/rm-vision"); err == nil {
+		t.Fatal("exhausted rate limit is not honored")
+	}
+	if calls != 1 {
+		t.Fatalf("unexpected calls: %d", calls)
+	}
+}
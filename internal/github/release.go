@@ -0,0 +1,125 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/internal/job"
+	"github.com/wintbiit/rmtv/utils"
+)
+
+const excerptLength = 300
+
+type User struct {
+	Login     string `json:"login"`
+	HtmlUrl   string `json:"html_url"`
+	AvatarUrl string `json:"avatar_url"`
+}
+
+type Release struct {
+	Id          int64     `json:"id"`
+	TagName     string    `json:"tag_name"`
+	Name        string    `json:"name"`
+	Body        string    `json:"body"`
+	BodyHtml    string    `json:"body_html"`
+	BodyText    string    `json:"body_text"`
+	HtmlUrl     string    `json:"html_url"`
+	Draft       bool      `json:"draft"`
+	Prerelease  bool      `json:"prerelease"`
+	PublishedAt time.Time `json:"published_at"`
+	Author      User      `json:"author"`
+
+	Repo string `json:"-"`
+}
+
+const releasesPageSize = 10
+
+// ListReleases returns the latest published releases of repo, newest first.
+func (c *Client) ListReleases(repo string) ([]*Release, error) {
+	var releases []*Release
+	resp, err := c.client.R().
+		SetRawPathParam("repo", repo).
+		SetQueryParam("per_page", fmt.Sprint(releasesPageSize)).
+		SetResult(&releases).
+		Get("repos/{repo}/releases")
+	if err != nil {
+		return nil, errors.Wrap(err, "list releases error")
+	}
+
+	if !resp.IsSuccess() {
+		return nil, errors.Errorf("list releases failed: %d %s", resp.StatusCode(), resp.String())
+	}
+
+	releases = lo.Filter(releases, func(item *Release, _ int) bool {
+		return !item.Draft
+	})
+	for _, release := range releases {
+		release.Repo = repo
+	}
+
+	return releases, nil
+}
+
+func (r *Release) GetType() string {
+	if r.Prerelease {
+		return "GitHub 预发布"
+	}
+
+	return "GitHub 发布"
+}
+
+func (r *Release) GetTypeColor() string {
+	if r.Prerelease {
+		return "yellow"
+	}
+
+	return "carmine"
+}
+
+func (r *Release) GetId() string {
+	return fmt.Sprintf("github-release-%d", r.Id)
+}
+
+func (r *Release) GetPic() *string {
+	return nil
+}
+
+func (r *Release) GetTitle() string {
+	return fmt.Sprintf("%s %s", r.Repo, lo.CoalesceOrEmpty(strings.TrimSpace(r.Name), r.TagName))
+}
+
+// GetDesc is an excerpt of the release notes.
+func (r *Release) GetDesc() string {
+	return utils.Excerpt(strings.TrimSpace(lo.CoalesceOrEmpty(r.BodyText, r.Body)), excerptLength)
+}
+
+func (r *Release) GetContent() string {
+	return utils.SanitizeHTML(r.BodyHtml)
+}
+
+func (r *Release) GetTags() []string {
+	return []string{r.Repo, r.TagName}
+}
+
+func (r *Release) GetPubDate() time.Time {
+	return r.PublishedAt
+}
+
+func (r *Release) GetAuthor() string {
+	return r.Author.Login
+}
+
+func (r *Release) GetAuthorUrl() string {
+	return r.Author.HtmlUrl
+}
+
+func (r *Release) GetUrl() string {
+	return r.HtmlUrl
+}
+
+func (r *Release) GetExtra() job.PostExtra {
+	return nil
+}
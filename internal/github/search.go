@@ -0,0 +1,142 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+type Repository struct {
+	Id              int64     `json:"id"`
+	FullName        string    `json:"full_name"`
+	Description     string    `json:"description"`
+	HtmlUrl         string    `json:"html_url"`
+	Language        string    `json:"language"`
+	Topics          []string  `json:"topics"`
+	StargazersCount int       `json:"stargazers_count"`
+	ForksCount      int       `json:"forks_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	Owner           User      `json:"owner"`
+
+	FoundAt time.Time `json:"-"`
+}
+
+type SearchRepositoriesResponse struct {
+	TotalCount int           `json:"total_count"`
+	Items      []*Repository `json:"items"`
+}
+
+const searchPageSize = 50
+
+func (c *Client) SearchTopic(topic string, minStars int) ([]*Repository, error) {
+	var result SearchRepositoriesResponse
+	resp, err := c.client.R().
+		SetQueryParam("q", fmt.Sprintf("topic:%s stars:>=%d", topic, minStars)).
+		SetQueryParam("sort", "stars").
+		SetQueryParam("per_page", strconv.Itoa(searchPageSize)).
+		SetResult(&result).
+		Get("search/repositories")
+	if err != nil {
+		return nil, errors.Wrap(err, "search repositories error")
+	}
+
+	if !resp.IsSuccess() {
+		return nil, errors.Errorf("search repositories failed: %d %s", resp.StatusCode(), resp.String())
+	}
+
+	return result.Items, nil
+}
+
+// NewTopicRepos returns the repositories of the topic that reached the star
+// threshold since the previous scan.
+func (c *Client) NewTopicRepos(ctx context.Context) ([]*Repository, error) {
+	repos, err := c.SearchTopic(c.topic, c.minStars)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s:topic:%s", c.name, c.topic)
+	names := lo.Map(repos, func(item *Repository, _ int) string {
+		return item.FullName
+	})
+	fresh, err := c.unseen(ctx, key, names)
+	if err != nil {
+		return nil, err
+	}
+	c.markSeen(key, names)
+
+	now := time.Now()
+	return lo.Filter(repos, func(item *Repository, _ int) bool {
+		item.FoundAt = now
+		return lo.Contains(fresh, item.FullName)
+	}), nil
+}
+
+func (r *Repository) GetType() string {
+	return "GitHub 仓库"
+}
+
+func (r *Repository) GetTypeColor() string {
+	return "green"
+}
+
+func (r *Repository) GetId() string {
+	return fmt.Sprintf("github-repo-%d", r.Id)
+}
+
+func (r *Repository) GetPic() *string {
+	return nil
+}
+
+func (r *Repository) GetTitle() string {
+	return r.FullName
+}
+
+func (r *Repository) GetDesc() string {
+	return r.Description
+}
+
+func (r *Repository) GetTags() []string {
+	return lo.Compact(append([]string{r.Language}, r.Topics...))
+}
+
+// GetPubDate is the time the repository was found above the threshold, so
+// that older repositories are not cut off by the lookback.
+func (r *Repository) GetPubDate() time.Time {
+	return r.FoundAt
+}
+
+func (r *Repository) GetAuthor() string {
+	return r.Owner.Login
+}
+
+func (r *Repository) GetAuthorUrl() string {
+	return r.Owner.HtmlUrl
+}
+
+func (r *Repository) GetUrl() string {
+	return r.HtmlUrl
+}
+
+type RepositoryExtra struct {
+	Stars int `json:"stars"`
+	Forks int `json:"forks"`
+}
+
+func (e *RepositoryExtra) String() string {
+	return fmt.Sprintf("<text_tag color='yellow'>⭐ %d</text_tag> "+
+		"<text_tag color='blue'>🍴 %d</text_tag>",
+		e.Stars, e.Forks)
+}
+
+func (r *Repository) GetExtra() job.PostExtra {
+	return &RepositoryExtra{
+		Stars: r.StargazersCount,
+		Forks: r.ForksCount,
+	}
+}
@@ -0,0 +1,175 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+type Tag struct {
+	Name   string `json:"name"`
+	Commit struct {
+		Sha string `json:"sha"`
+	} `json:"commit"`
+
+	Repo   string    `json:"-"`
+	Date   time.Time `json:"-"`
+	Author User      `json:"-"`
+}
+
+type Commit struct {
+	Sha     string `json:"sha"`
+	HtmlUrl string `json:"html_url"`
+	Commit  struct {
+		Message   string `json:"message"`
+		Committer struct {
+			Name string    `json:"name"`
+			Date time.Time `json:"date"`
+		} `json:"committer"`
+	} `json:"commit"`
+	Author *User `json:"author"`
+}
+
+const tagsPageSize = 30
+
+func (c *Client) ListTags(repo string) ([]*Tag, error) {
+	var tags []*Tag
+	resp, err := c.client.R().
+		SetRawPathParam("repo", repo).
+		SetQueryParam("per_page", fmt.Sprint(tagsPageSize)).
+		SetResult(&tags).
+		Get("repos/{repo}/tags")
+	if err != nil {
+		return nil, errors.Wrap(err, "list tags error")
+	}
+
+	if !resp.IsSuccess() {
+		return nil, errors.Errorf("list tags failed: %d %s", resp.StatusCode(), resp.String())
+	}
+
+	for _, tag := range tags {
+		tag.Repo = repo
+	}
+
+	return tags, nil
+}
+
+func (c *Client) GetCommit(repo, sha string) (*Commit, error) {
+	var commit Commit
+	resp, err := c.client.R().
+		SetRawPathParam("repo", repo).
+		SetPathParam("sha", sha).
+		SetResult(&commit).
+		Get("repos/{repo}/commits/{sha}")
+	if err != nil {
+		return nil, errors.Wrap(err, "get commit error")
+	}
+
+	if !resp.IsSuccess() {
+		return nil, errors.Errorf("get commit failed: %d %s", resp.StatusCode(), resp.String())
+	}
+
+	return &commit, nil
+}
+
+// NewTags returns the tags of repo pushed since the previous scan. Tags with
+// a release are left to the release, tags whose commit cannot be fetched are
+// tried again by the next scan.
+func (c *Client) NewTags(ctx context.Context, repo string, releases []*Release) ([]*Tag, error) {
+	tags, err := c.ListTags(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s:tags:%s", c.name, repo)
+	names := lo.Map(tags, func(item *Tag, _ int) string {
+		return item.Name
+	})
+	fresh, err := c.unseen(ctx, key, names)
+	if err != nil {
+		return nil, err
+	}
+
+	released := lo.Map(releases, func(item *Release, _ int) string {
+		return item.TagName
+	})
+
+	results := make([]*Tag, 0)
+	var failed []string
+	for _, tag := range tags {
+		if !lo.Contains(fresh, tag.Name) || lo.Contains(released, tag.Name) {
+			continue
+		}
+
+		commit, err := c.GetCommit(repo, tag.Commit.Sha)
+		if err != nil {
+			logrus.Errorf("Failed to get commit of %s tag %s: %v", repo, tag.Name, err)
+			failed = append(failed, tag.Name)
+			continue
+		}
+
+		tag.Date = commit.Commit.Committer.Date
+		tag.Author = User{Login: commit.Commit.Committer.Name}
+		if commit.Author != nil {
+			tag.Author = *commit.Author
+		}
+		results = append(results, tag)
+	}
+
+	c.markSeen(key, lo.Without(names, failed...))
+
+	return results, nil
+}
+
+func (t *Tag) GetType() string {
+	return "GitHub 标签"
+}
+
+func (t *Tag) GetTypeColor() string {
+	return "purple"
+}
+
+func (t *Tag) GetId() string {
+	return fmt.Sprintf("github-tag-%s-%s", t.Repo, t.Name)
+}
+
+func (t *Tag) GetPic() *string {
+	return nil
+}
+
+func (t *Tag) GetTitle() string {
+	return fmt.Sprintf("%s %s", t.Repo, t.Name)
+}
+
+func (t *Tag) GetDesc() string {
+	return fmt.Sprintf("新标签 %s，提交 %s", t.Name, t.Commit.Sha[:min(7, len(t.Commit.Sha))])
+}
+
+func (t *Tag) GetTags() []string {
+	return []string{t.Repo}
+}
+
+func (t *Tag) GetPubDate() time.Time {
+	return t.Date
+}
+
+func (t *Tag) GetAuthor() string {
+	return t.Author.Login
+}
+
+func (t *Tag) GetAuthorUrl() string {
+	return t.Author.HtmlUrl
+}
+
+func (t *Tag) GetUrl() string {
+	return fmt.Sprintf("https://github.com/%s/releases/tag/%s", t.Repo, t.Name)
+}
+
+func (t *Tag) GetExtra() job.PostExtra {
+	return nil
+}
@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// Backfill stores the posts of source published after since without pushing
+// them to consumers.
+func (j *TvJob) Backfill(ctx context.Context, source string, since time.Time) error {
+	p, ok := lo.Find(j.providers, func(item MessageProvider) bool {
+		return item.Name() == source
+	})
+	if !ok {
+		return errors.Errorf("provider %s is not enabled", source)
+	}
+
+	incremental, ok := p.(IncrementalProvider)
+	if !ok {
+		return errors.Errorf("provider %s does not support backfill", source)
+	}
+
+	if err := j.open(ctx); err != nil {
+		return err
+	}
+	defer j.db.Close()
+
+	collect := incremental.CollectSince
+	if backfill, ok := p.(BackfillProvider); ok {
+		collect = backfill.BackfillSince
+	}
+
+	messages, err := collect(since)
+	var partial *PartialError
+	if errors.As(err, &partial) {
+		for _, e := range partial.Errs {
+			logrus.Warnf("Skipped %s record: %v", source, e)
+		}
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to collect %s", source)
+	}
+
+	messages = lo.Filter(messages, func(item Post, _ int) bool {
+		return !item.GetPubDate().Before(since)
+	})
+
+	count, err := j.store(ctx, p, messages, false)
+	if err != nil {
+		return errors.Wrapf(err, "failed to store %s", source)
+	}
+
+	logrus.Infof("backfilled %d %s posts since %s", count, source, since.Format(time.DateOnly))
+
+	return nil
+}
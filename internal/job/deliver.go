@@ -0,0 +1,228 @@
+package job
+
+import (
+	"context"
+	errors2 "errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/delivery"
+	"github.com/wintbiit/rmtv/ent/post"
+)
+
+const (
+	retryBackoff    = time.Minute
+	retryBackoffMax = time.Hour
+)
+
+// TargetedConsumer is implemented by consumers pushing to several targets,
+// such as chats or webhooks. Their deliveries are tracked per target, so a
+// failing target is retried without pushing again to the others.
+type TargetedConsumer interface {
+	MessageConsumer
+	// Targets returns the keys of the targets posts are pushed to.
+	Targets(ctx context.Context) ([]string, error)
+	// PushMessageTo pushes videos to the target with the given key.
+	PushMessageTo(ctx context.Context, target string, videos []Post) error
+}
+
+// deliver pushes the due deliveries of every consumer. Each consumer and
+// target is retried independently, so one failing consumer never re-pushes
+// posts to the others.
+func (j *TvJob) deliver(ctx context.Context) error {
+	j.deliverMu.Lock()
+	defer j.deliverMu.Unlock()
+
+	errs := make([]error, 0, len(j.consumers))
+	for _, consumer := range j.consumers {
+		if err := j.deliverTo(ctx, consumer); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to deliver to %s", consumer.Name()))
+		}
+	}
+
+	return errors2.Join(errs...)
+}
+
+func (j *TvJob) deliverTo(ctx context.Context, consumer MessageConsumer) error {
+	if targeted, ok := consumer.(TargetedConsumer); ok {
+		if err := j.split(ctx, targeted); err != nil {
+			return err
+		}
+	}
+
+	deliveries, err := j.db.Delivery.Query().
+		Where(
+			delivery.ConsumerEQ(consumer.Name()),
+			delivery.StatusEQ(delivery.StatusPending),
+			delivery.NextRetryAtLTE(time.Now()),
+		).
+		WithPost().
+		WithRevision().
+		Order(delivery.ByPostField(post.FieldPubDate, sql.OrderDesc()), delivery.ByID()).
+		All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to query deliveries")
+	}
+
+	targets := lo.Uniq(lo.Map(deliveries, func(item *ent.Delivery, _ int) string {
+		return item.Target
+	}))
+	byTarget := lo.GroupBy(deliveries, func(item *ent.Delivery) string {
+		return item.Target
+	})
+
+	errs := make([]error, 0, len(targets))
+	for _, target := range targets {
+		if err := j.push(ctx, consumer, target, byTarget[target]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors2.Join(errs...)
+}
+
+// split replaces the pending deliveries of a targeted consumer with one
+// delivery per target.
+func (j *TvJob) split(ctx context.Context, consumer TargetedConsumer) error {
+	pending, err := j.db.Delivery.Query().
+		Where(
+			delivery.ConsumerEQ(consumer.Name()),
+			delivery.StatusEQ(delivery.StatusPending),
+			delivery.TargetEQ(""),
+		).
+		All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to query deliveries")
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	targets, err := consumer.Targets(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get targets")
+	}
+
+	tx, err := j.db.Tx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction")
+	}
+	defer tx.Rollback()
+
+	if err := tx.Delivery.CreateBulk(lo.FlatMap(pending, func(item *ent.Delivery, _ int) []*ent.DeliveryCreate {
+		return lo.Map(targets, func(target string, _ int) *ent.DeliveryCreate {
+			return tx.Delivery.Create().
+				SetPostID(item.PostID).
+				SetNillableRevisionID(item.RevisionID).
+				SetConsumer(item.Consumer).
+				SetTarget(target).
+				SetCreatedAt(item.CreatedAt)
+		})
+	})...).Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to create target deliveries")
+	}
+
+	if _, err := tx.Delivery.Delete().
+		Where(delivery.IDIn(lo.Map(pending, func(item *ent.Delivery, _ int) int {
+			return item.ID
+		})...)).
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to delete split deliveries")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// push pushes the deliveries of a single target, or of the whole consumer
+// when target is empty.
+func (j *TvJob) push(ctx context.Context, consumer MessageConsumer, target string, deliveries []*ent.Delivery) error {
+	name := consumer.Name()
+	if target != "" {
+		name += " " + target
+	}
+
+	// Deliveries over the limit stay pending for the next round.
+	if j.maxCountPerPush > 0 && len(deliveries) > j.maxCountPerPush {
+		logrus.Infof("%s: deferred %d deliveries over max count per push", name, len(deliveries)-j.maxCountPerPush)
+		deliveries = deliveries[:j.maxCountPerPush]
+	}
+
+	entries := lo.Map(deliveries, func(item *ent.Delivery, _ int) Post {
+		if item.Edges.Revision != nil {
+			return updatedPost{storedPost{item.Edges.Post}, item.Edges.Revision}
+		}
+		return storedPost{item.Edges.Post}
+	})
+	ids := lo.Map(deliveries, func(item *ent.Delivery, _ int) int {
+		return item.ID
+	})
+
+	logrus.Infof("%s: pushing %d entries: %v", name, len(entries), lo.Map(entries, func(item Post, _ int) string {
+		return item.GetId()
+	}))
+
+	var pushErr error
+	if targeted, ok := consumer.(TargetedConsumer); ok && target != "" {
+		pushErr = targeted.PushMessageTo(ctx, target, entries)
+	} else {
+		pushErr = consumer.PushMessage(ctx, entries)
+	}
+	if pushErr != nil {
+		err := j.retry(ctx, deliveries, pushErr)
+		if target != "" {
+			err = errors.Wrapf(err, "target %s", target)
+		}
+		return err
+	}
+
+	if err := j.db.Delivery.Update().
+		Where(delivery.IDIn(ids...)).
+		SetStatus(delivery.StatusDelivered).
+		AddAttempts(1).
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to mark deliveries delivered")
+	}
+
+	logrus.Infof("pushed %d messages to %s", len(entries), name)
+
+	return nil
+}
+
+func (j *TvJob) retry(ctx context.Context, deliveries []*ent.Delivery, pushErr error) error {
+	for _, d := range deliveries {
+		attempts := d.Attempts + 1
+		update := j.db.Delivery.UpdateOne(d).
+			SetAttempts(attempts).
+			SetLastError(pushErr.Error())
+
+		if attempts >= j.maxAttempts {
+			update = update.SetStatus(delivery.StatusFailed)
+		} else {
+			update = update.SetNextRetryAt(time.Now().Add(backoff(attempts)))
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to update delivery")
+		}
+	}
+
+	return errors.Wrap(pushErr, "failed to push message")
+}
+
+func backoff(attempts int) time.Duration {
+	d := retryBackoff << (attempts - 1)
+	if d <= 0 || d > retryBackoffMax {
+		return retryBackoffMax
+	}
+
+	return d
+}
@@ -0,0 +1,144 @@
+package job
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/wintbiit/rmtv/ent/delivery"
+)
+
+func TestDeliverTargets(t *testing.T) {
+	chats := newRecordingConsumer("lark", "oc_a", "oc_b")
+	j := newTestJob(t, WithConsumer(targetedConsumer{chats}))
+	p := &testProvider{name: "bilibili", posts: []Post{testItem{id: "BV1", date: time.Now()}}}
+	ctx := context.Background()
+
+	chats.setFailing("oc_b", true)
+	j.scanProvider(ctx, p)
+	if err := j.deliver(ctx); err == nil {
+		t.Fatal("delivery to a failing chat succeeded")
+	}
+
+	// The retry reaches only the chat that failed.
+	chats.setFailing("oc_b", false)
+	makeDue(t, j)
+	if err := j.deliver(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if pushes := chats.pushed("oc_a"); len(pushes) != 1 {
+		t.Errorf("oc_a pushed %v, want once", pushes)
+	}
+	if pushes := chats.pushed("oc_b"); len(pushes) != 2 || !slices.Equal(pushes[1], []string{"BV1"}) {
+		t.Errorf("oc_b pushed %v, want twice", pushes)
+	}
+	if pushes := chats.pushed(""); len(pushes) != 0 {
+		t.Errorf("pushed to every chat at once: %v", pushes)
+	}
+
+	delivered, err := j.db.Delivery.Query().Where(delivery.StatusEQ(delivery.StatusDelivered)).Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total := j.db.Delivery.Query().CountX(ctx); delivered != 2 || total != 2 {
+		t.Errorf("%d of %d deliveries delivered, want one per chat", delivered, total)
+	}
+}
+
+func TestDeliverFailingConsumer(t *testing.T) {
+	lark, webhook := newRecordingConsumer("lark"), newRecordingConsumer("lark-webhook")
+	j := newTestJob(t, WithConsumer(lark), WithConsumer(webhook))
+	p := &testProvider{name: "bilibili", posts: []Post{testItem{id: "BV1", date: time.Now()}}}
+	ctx := context.Background()
+
+	webhook.setFailing("", true)
+	j.scanProvider(ctx, p)
+	for range 2 {
+		makeDue(t, j)
+		if err := j.deliver(ctx); err == nil {
+			t.Fatal("delivery to a failing consumer succeeded")
+		}
+	}
+
+	if pushes := lark.pushed(""); len(pushes) != 1 {
+		t.Errorf("lark pushed %v, want once", pushes)
+	}
+	if pushes := webhook.pushed(""); len(pushes) != 2 {
+		t.Errorf("webhook pushed %v, want twice", pushes)
+	}
+}
+
+func TestDeliverBackoff(t *testing.T) {
+	webhook := newRecordingConsumer("lark-webhook")
+	j := newTestJob(t, WithConsumer(webhook), WithMaxAttempts(3))
+	p := &testProvider{name: "bilibili", posts: []Post{testItem{id: "BV1", date: time.Now()}}}
+	ctx := context.Background()
+
+	webhook.setFailing("", true)
+	j.scanProvider(ctx, p)
+
+	var last time.Duration
+	for attempt := 1; attempt <= 3; attempt++ {
+		makeDue(t, j)
+		start := time.Now()
+		j.deliver(ctx)
+
+		d := j.db.Delivery.Query().OnlyX(ctx)
+		if d.Attempts != attempt || d.LastError == "" {
+			t.Fatalf("attempt %d: delivery has %d attempts, error %q", attempt, d.Attempts, d.LastError)
+		}
+		if attempt == 3 {
+			if d.Status != delivery.StatusFailed {
+				t.Fatalf("delivery is %s after max attempts", d.Status)
+			}
+			break
+		}
+
+		if d.Status != delivery.StatusPending {
+			t.Fatalf("attempt %d: delivery is %s", attempt, d.Status)
+		}
+		wait := d.NextRetryAt.Sub(start)
+		if wait < backoff(attempt) || wait <= last {
+			t.Fatalf("attempt %d: retried after %v, previous %v", attempt, wait, last)
+		}
+		last = wait
+	}
+
+	// Failed deliveries are not pushed again.
+	makeDue(t, j)
+	j.deliver(ctx)
+	if pushes := webhook.pushed(""); len(pushes) != 3 {
+		t.Errorf("pushed %d times, want 3", len(pushes))
+	}
+}
+
+func TestDeliverMaxCountPerPush(t *testing.T) {
+	lark := newRecordingConsumer("lark")
+	j := newTestJob(t, WithConsumer(lark), WithMaxCountPerPush(2))
+	now := time.Now()
+	p := &testProvider{name: "bilibili", posts: []Post{
+		testItem{id: "BV1", date: now.Add(-3 * time.Minute)},
+		testItem{id: "BV2", date: now.Add(-2 * time.Minute)},
+		testItem{id: "BV3", date: now.Add(-time.Minute)},
+	}}
+	ctx := context.Background()
+
+	j.scanProvider(ctx, p)
+	if err := j.deliver(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if pending := j.db.Delivery.Query().Where(delivery.StatusEQ(delivery.StatusPending)).CountX(ctx); pending != 1 {
+		t.Fatalf("%d deliveries pending, want 1", pending)
+	}
+
+	// The newest posts go first, the rest in the next round.
+	if err := j.deliver(ctx); err != nil {
+		t.Fatal(err)
+	}
+	pushes := lark.pushed("")
+	if len(pushes) != 2 || !slices.Equal(pushes[0], []string{"BV3", "BV2"}) || !slices.Equal(pushes[1], []string{"BV1"}) {
+		t.Fatalf("unexpected pushes %v", pushes)
+	}
+}
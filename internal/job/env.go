@@ -0,0 +1,105 @@
+package job
+
+import (
+	errors2 "errors"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// loadEnv sets the fields of the struct pointed to by config from the
+// environment variables named by their env tag, trying each prefix in turn.
+// Lists are comma separated.
+func loadEnv(config any, prefixes ...string) error {
+	v := reflect.ValueOf(config).Elem()
+	var errs []error
+	for i := 0; i < v.NumField(); i++ {
+		key := v.Type().Field(i).Tag.Get("env")
+		if key == "" {
+			continue
+		}
+
+		for _, prefix := range prefixes {
+			raw, ok := os.LookupEnv(prefix + key)
+			if !ok {
+				continue
+			}
+			if err := setField(v.Field(i), raw); err != nil {
+				errs = append(errs, errors.Wrapf(err, "invalid %s%s", prefix, key))
+			}
+			break
+		}
+	}
+
+	return errors2.Join(errs...)
+}
+
+func envKeys(config any) []string {
+	t := reflect.TypeOf(config).Elem()
+	keys := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if key := t.Field(i).Tag.Get("env"); key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func setField(field reflect.Value, raw string) error {
+	raw = strings.TrimSpace(raw)
+
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		items := lo.Compact(lo.Map(strings.Split(raw, ","), func(item string, _ int) string {
+			return strings.TrimSpace(item)
+		}))
+		slice := reflect.MakeSlice(field.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setField(slice.Index(i), item); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+	default:
+		return errors.Errorf("unsupported type %s", field.Type())
+	}
+
+	return nil
+}
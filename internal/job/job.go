@@ -2,13 +2,13 @@ package job
 
 import (
 	"context"
-	errors2 "errors"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
-	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/migrate"
 
 	_ "github.com/lib/pq"
 )
@@ -16,9 +16,17 @@ import (
 type TvJob struct {
 	providers       []MessageProvider
 	consumers       []MessageConsumer
+	routes          []Route
 	dbUrl           string
 	db              *ent.Client
 	maxCountPerPush int
+	interval        time.Duration
+	intervals       map[string]time.Duration
+	jitter          float64
+	lookback        time.Duration
+	maxAttempts     int
+	notifyUpdates   bool
+	deliverMu       sync.Mutex
 }
 
 type TvJobOption func(*TvJob)
@@ -38,6 +46,66 @@ func WithMaxCountPerPush(count int) TvJobOption {
 	}
 }
 
+// WithInterval sets the default interval between scans of a provider in daemon mode.
+func WithInterval(interval time.Duration) TvJobOption {
+	return func(j *TvJob) {
+		if interval <= 0 {
+			logrus.Fatal("interval must be greater than 0")
+		}
+		j.interval = interval
+	}
+}
+
+// WithProviderInterval overrides the daemon scan interval of the named provider.
+func WithProviderInterval(name string, interval time.Duration) TvJobOption {
+	return func(j *TvJob) {
+		if interval <= 0 {
+			logrus.Fatalf("interval of %s must be greater than 0", name)
+		}
+		j.intervals[name] = interval
+	}
+}
+
+// WithJitter randomizes every daemon scan interval by up to the given fraction of it.
+func WithJitter(jitter float64) TvJobOption {
+	return func(j *TvJob) {
+		if jitter < 0 || jitter >= 1 {
+			logrus.Fatal("jitter must be in [0, 1)")
+		}
+		j.jitter = jitter
+	}
+}
+
+// WithLookback ignores unseen posts published longer than lookback ago, so a
+// provider returning old items for the first time does not flood consumers.
+func WithLookback(lookback time.Duration) TvJobOption {
+	return func(j *TvJob) {
+		if lookback < 0 {
+			logrus.Fatal("lookback must not be negative")
+		}
+		j.lookback = lookback
+	}
+}
+
+// WithMaxAttempts sets how many times a post is pushed to a consumer before
+// its delivery is given up.
+func WithMaxAttempts(attempts int) TvJobOption {
+	return func(j *TvJob) {
+		if attempts <= 0 {
+			logrus.Fatal("maxAttempts must be greater than 0")
+		}
+		j.maxAttempts = attempts
+	}
+}
+
+// WithNotifyUpdates pushes an update notification to consumers whenever the
+// tracked snapshot of a stored post changes.
+func WithNotifyUpdates(notify bool) TvJobOption {
+	return func(j *TvJob) {
+		j.notifyUpdates = notify
+	}
+}
+
 func WithProvider(p MessageProvider) TvJobOption {
 	return func(j *TvJob) {
 		j.providers = append(j.providers, p)
@@ -53,6 +121,10 @@ func WithConsumer(c MessageConsumer) TvJobOption {
 func NewTvJob(options ...TvJobOption) *TvJob {
 	job := &TvJob{
 		maxCountPerPush: 10,
+		interval:        time.Hour,
+		intervals:       make(map[string]time.Duration),
+		jitter:          0.1,
+		maxAttempts:     5,
 	}
 
 	for _, option := range options {
@@ -69,18 +141,47 @@ func (j *TvJob) With(options ...TvJobOption) *TvJob {
 	return j
 }
 
-func (j *TvJob) Run(ctx context.Context) error {
-	var err error
-	j.db, err = ent.Open("postgres", j.dbUrl)
+func (j *TvJob) open(ctx context.Context) error {
+	db, err := ent.Open("postgres", j.dbUrl)
 	if err != nil {
 		return errors.Wrap(err, "failed to open db")
 	}
-	defer j.db.Close()
-	if err := j.db.Schema.Create(ctx); err != nil {
+	// Indexes are dropped when replaced, as when deliveries gained targets.
+	if err := db.Schema.Create(ctx, migrate.WithDropIndex(true)); err != nil {
+		db.Close()
 		return errors.Wrap(err, "failed to create schema")
 	}
 
-	if err = j.scan(ctx); err != nil {
+	j.setDb(db)
+
+	return nil
+}
+
+// setDb hands db to the job and to the providers and consumers keeping
+// state in it.
+func (j *TvJob) setDb(db *ent.Client) {
+	j.db = db
+	store := &Store{db: db}
+	for _, p := range j.providers {
+		if stateful, ok := p.(StatefulProvider); ok {
+			stateful.SetStore(store)
+		}
+	}
+	for _, c := range j.consumers {
+		if dc, ok := c.(DatabaseConsumer); ok {
+			dc.SetDb(db)
+		}
+	}
+}
+
+// Run scans every provider once and returns.
+func (j *TvJob) Run(ctx context.Context) error {
+	if err := j.open(ctx); err != nil {
+		return err
+	}
+	defer j.db.Close()
+
+	if err := j.scan(ctx, j.providers); err != nil {
 		return errors.Wrap(err, "initial scan failed")
 	}
 
@@ -105,28 +206,3 @@ type Post interface {
 	GetUrl() string
 	GetExtra() PostExtra
 }
-
-func (j *TvJob) onNewMessage(ctx context.Context, entries []Post) error {
-	logrus.Infof("Incoming %d new entries: %v", len(entries), lo.Map(entries, func(item Post, _ int) string {
-		return item.GetId()
-	}))
-
-	if j.maxCountPerPush > 0 && len(entries) > j.maxCountPerPush {
-		entries = entries[:j.maxCountPerPush]
-	}
-
-	errs := make([]error, 0, len(j.consumers))
-	for _, consumer := range j.consumers {
-		if err := consumer.PushMessage(ctx, entries); err != nil {
-			errs = append(errs, errors.Wrap(err, "failed to push message"))
-		}
-	}
-
-	if err := errors2.Join(errs...); err != nil {
-		return err
-	}
-
-	logrus.Infof("pushed %d messages to %d consumers", len(entries), len(j.consumers))
-
-	return nil
-}
@@ -0,0 +1,170 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/delivery"
+	"github.com/wintbiit/rmtv/ent/schema"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestJob returns a job storing into a fresh in-memory database.
+func newTestJob(t *testing.T, options ...TvJobOption) *TvJob {
+	drv, err := sql.Open(dialect.SQLite, "file::memory:?_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection would open a database of its own.
+	drv.DB().SetMaxOpenConns(1)
+
+	db := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() {
+		db.Close()
+	})
+	if err := db.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	j := NewTvJob(options...)
+	j.setDb(db)
+
+	return j
+}
+
+type testItem struct {
+	id      string
+	title   string
+	desc    string
+	content string
+	date    time.Time
+}
+
+func (p testItem) GetType() string       { return "test" }
+func (p testItem) GetTypeColor() string  { return "blue" }
+func (p testItem) GetId() string         { return p.id }
+func (p testItem) GetPic() *string       { return nil }
+func (p testItem) GetTitle() string      { return lo.CoalesceOrEmpty(p.title, p.id) }
+func (p testItem) GetDesc() string       { return p.desc }
+func (p testItem) GetTags() []string     { return nil }
+func (p testItem) GetPubDate() time.Time { return p.date }
+func (p testItem) GetAuthor() string     { return "" }
+func (p testItem) GetAuthorUrl() string  { return "" }
+func (p testItem) GetUrl() string        { return "https://example.com/" + p.id }
+func (p testItem) GetExtra() PostExtra   { return nil }
+func (p testItem) GetContent() string    { return p.content }
+
+type testProvider struct {
+	name  string
+	posts []Post
+}
+
+func (p *testProvider) Collect() ([]Post, error) {
+	return p.posts, nil
+}
+
+func (p *testProvider) Name() string {
+	return p.name
+}
+
+var errFailingTarget = errors.New("target is failing")
+
+// recordingConsumer records the ids of every push, failing the pushes to
+// the targets in failing.
+type recordingConsumer struct {
+	name    string
+	targets []string
+
+	mu      sync.Mutex
+	failing map[string]bool
+	pushes  map[string][][]string
+}
+
+func newRecordingConsumer(name string, targets ...string) *recordingConsumer {
+	return &recordingConsumer{
+		name:    name,
+		targets: targets,
+		failing: make(map[string]bool),
+		pushes:  make(map[string][][]string),
+	}
+}
+
+func (c *recordingConsumer) Name() string {
+	return c.name
+}
+
+func (c *recordingConsumer) PushMessage(ctx context.Context, videos []Post) error {
+	return c.PushMessageTo(ctx, "", videos)
+}
+
+func (c *recordingConsumer) PushMessageTo(ctx context.Context, target string, videos []Post) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pushes[target] = append(c.pushes[target], lo.Map(videos, func(item Post, _ int) string {
+		return item.GetId()
+	}))
+	if c.failing[target] {
+		return errFailingTarget
+	}
+
+	return nil
+}
+
+func (c *recordingConsumer) setFailing(target string, failing bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failing[target] = failing
+}
+
+// pushed returns the ids pushed to target, one slice per push.
+func (c *recordingConsumer) pushed(target string) [][]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.pushes[target]
+}
+
+// targetedConsumer is a recordingConsumer tracked per target.
+type targetedConsumer struct {
+	*recordingConsumer
+}
+
+func (c targetedConsumer) Targets(ctx context.Context) ([]string, error) {
+	return c.targets, nil
+}
+
+// makeDue makes every pending delivery due for a retry now.
+func makeDue(t *testing.T, j *TvJob) {
+	if err := j.db.Delivery.Update().
+		Where(delivery.StatusEQ(delivery.StatusPending)).
+		SetNextRetryAt(time.Now().Add(-time.Second)).
+		Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// trackedItem is a testItem of a mutable source, notifying changes of the
+// fields in notify.
+type trackedItem struct {
+	testItem
+	snapshot map[string]string
+	notify   []string
+}
+
+func (p trackedItem) GetSnapshot() map[string]string {
+	return p.snapshot
+}
+
+func (p trackedItem) NotifyChange(change schema.Change) bool {
+	return lo.Contains(p.notify, change.Field)
+}
@@ -0,0 +1,159 @@
+package job
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wintbiit/rmtv/ent"
+)
+
+// storedPost adapts a persisted post back to the Post interface, so that
+// deliveries can be retried long after the provider returned it.
+type storedPost struct {
+	*ent.Post
+}
+
+type textExtra string
+
+func (e textExtra) String() string {
+	return string(e)
+}
+
+func extraText(item Post) string {
+	if extra := item.GetExtra(); extra != nil {
+		return extra.String()
+	}
+
+	return ""
+}
+
+func content(item Post) string {
+	if cp, ok := item.(ContentPost); ok {
+		return cp.GetContent()
+	}
+
+	return ""
+}
+
+// SourcedPost is implemented by the posts handed to consumers, which are
+// always stored ones, to tell the source they were collected from.
+type SourcedPost interface {
+	Post
+	GetSource() string
+}
+
+// NewStoredPost presents a persisted post as collected, e.g. to push posts
+// stored earlier.
+func NewStoredPost(p *ent.Post) SourcedPost {
+	return storedPost{p}
+}
+
+func (p storedPost) GetSource() string {
+	return p.Source
+}
+
+func (p storedPost) GetContent() string {
+	return p.Content
+}
+
+func (p storedPost) GetType() string {
+	if p.Type == "" {
+		return p.Source
+	}
+
+	return p.Type
+}
+
+func (p storedPost) GetTypeColor() string {
+	if p.TypeColor == "" {
+		return "neutral"
+	}
+
+	return p.TypeColor
+}
+
+func (p storedPost) GetId() string {
+	return p.ID
+}
+
+func (p storedPost) GetPic() *string {
+	return p.Picture
+}
+
+func (p storedPost) GetTitle() string {
+	return p.Title
+}
+
+func (p storedPost) GetDesc() string {
+	return p.Description
+}
+
+func (p storedPost) GetTags() []string {
+	return p.Tags
+}
+
+func (p storedPost) GetPubDate() time.Time {
+	return p.PubDate
+}
+
+func (p storedPost) GetAuthor() string {
+	return p.Author
+}
+
+func (p storedPost) GetAuthorUrl() string {
+	return p.AuthorURL
+}
+
+func (p storedPost) GetUrl() string {
+	return p.URL
+}
+
+func (p storedPost) GetExtra() PostExtra {
+	return textExtra(p.ExtraText)
+}
+
+// UpdatedPost is implemented by the update notifications handed to
+// consumers, to tell the revision they announce.
+type UpdatedPost interface {
+	SourcedPost
+	GetRevisionId() int
+}
+
+// updatedPost presents a revision of a stored post as an update notification
+// whose description lists the changed fields.
+type updatedPost struct {
+	storedPost
+	revision *ent.PostRevision
+}
+
+// NewUpdatedPost presents a revision of a persisted post as the update
+// notification pushed for it, e.g. to rebuild a card pushed earlier.
+func NewUpdatedPost(p *ent.Post, revision *ent.PostRevision) UpdatedPost {
+	return updatedPost{storedPost{p}, revision}
+}
+
+func (p updatedPost) GetRevisionId() int {
+	return p.revision.ID
+}
+
+func (p updatedPost) GetType() string {
+	return p.storedPost.GetType() + " 更新"
+}
+
+func (p updatedPost) GetDesc() string {
+	lines := make([]string, 0, len(p.revision.Changes))
+	for _, change := range p.revision.Changes {
+		old := change.Old
+		if old == "" {
+			old = "-"
+		}
+		lines = append(lines, fmt.Sprintf("**%s**: %s → %s", change.Field, old, change.New))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (p updatedPost) GetPubDate() time.Time {
+	return p.revision.CreatedAt
+}
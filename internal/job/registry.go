@@ -0,0 +1,133 @@
+package job
+
+import (
+	errors2 "errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// ProviderType is a kind of provider that can be enabled, possibly several
+// times under different instance names.
+type ProviderType struct {
+	Name        string
+	Description string
+	// EnvPrefix prefixes the environment variables of the config, e.g. BILI_.
+	// Instances not named after their type read variables prefixed with their
+	// own name first, falling back to EnvPrefix.
+	EnvPrefix string
+
+	newConfig func() any
+	validate  func(any) error
+	build     func(name string, config any) (MessageProvider, error)
+}
+
+var providerTypes = make(map[string]*ProviderType)
+
+// Register adds a provider type. It is called from the init function of the
+// provider's package, which is enabled by importing it.
+func Register[C any](name, envPrefix, description string, defaults func() *C, validate func(*C) error, factory func(name string, config *C) (MessageProvider, error)) {
+	if _, ok := providerTypes[name]; ok {
+		panic(fmt.Sprintf("provider type %s registered twice", name))
+	}
+
+	providerTypes[name] = &ProviderType{
+		Name:        name,
+		Description: description,
+		EnvPrefix:   envPrefix,
+		newConfig: func() any {
+			return defaults()
+		},
+		validate: func(config any) error {
+			return validate(config.(*C))
+		},
+		build: func(name string, config any) (MessageProvider, error) {
+			return factory(name, config.(*C))
+		},
+	}
+}
+
+// ProviderTypes lists the registered provider types by name.
+func ProviderTypes() []*ProviderType {
+	types := lo.Values(providerTypes)
+	slices.SortFunc(types, func(a, b *ProviderType) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return types
+}
+
+// EnvPrefixes returns the prefixes of the environment variables read for the
+// instance name, most specific first.
+func (t *ProviderType) EnvPrefixes(name string) []string {
+	if name == t.Name {
+		return []string{t.EnvPrefix}
+	}
+
+	return []string{strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_", t.EnvPrefix}
+}
+
+// EnvVars lists the environment variables of the type's config.
+func (t *ProviderType) EnvVars() []string {
+	return lo.Map(envKeys(t.newConfig()), func(item string, _ int) string {
+		return t.EnvPrefix + item
+	})
+}
+
+// Instance is an enabled provider before it is built.
+type Instance struct {
+	Name   string
+	Type   *ProviderType
+	Config any
+}
+
+// NewInstance creates an instance named name of the provider type with the
+// type's default config.
+func NewInstance(name, typeName string) (*Instance, error) {
+	t, ok := providerTypes[typeName]
+	if !ok {
+		return nil, errors.Errorf("%s: unknown provider type %q", name, typeName)
+	}
+
+	return &Instance{
+		Name:   name,
+		Type:   t,
+		Config: t.newConfig(),
+	}, nil
+}
+
+// LoadEnv overrides the instance config with its environment variables.
+func (i *Instance) LoadEnv() error {
+	return i.wrap(loadEnv(i.Config, i.Type.EnvPrefixes(i.Name)...))
+}
+
+func (i *Instance) Validate() error {
+	return i.wrap(i.Type.validate(i.Config))
+}
+
+// wrap prefixes each of the joined errors of err with the instance name.
+func (i *Instance) wrap(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return errors2.Join(lo.Map(joined.Unwrap(), func(item error, _ int) error {
+			return errors.Wrap(item, i.Name)
+		})...)
+	}
+
+	return errors.Wrap(err, i.Name)
+}
+
+func (i *Instance) New() (MessageProvider, error) {
+	p, err := i.Type.build(i.Name, i.Config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create provider %s", i.Name)
+	}
+
+	return p, nil
+}
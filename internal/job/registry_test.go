@@ -0,0 +1,104 @@
+package job
+
+import (
+	errors2 "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type testConfig struct {
+	Keywords []string      `env:"KEYWORDS"`
+	Rooms    []int64       `env:"ROOMS"`
+	MaxPages int           `env:"MAX_PAGES"`
+	Official bool          `env:"OFFICIAL"`
+	Interval time.Duration `env:"INTERVAL"`
+	Cookies  string        `env:"COOKIES"`
+}
+
+func init() {
+	Register("test", "TEST_", "test provider", func() *testConfig {
+		return &testConfig{Keywords: []string{"RoboMaster"}, MaxPages: 5}
+	}, func(config *testConfig) error {
+		if config.Cookies == "" {
+			return errors.New("cookies is required")
+		}
+		return nil
+	}, func(name string, config *testConfig) (MessageProvider, error) {
+		return &testProvider{name: name}, nil
+	})
+}
+
+// configure creates the instance name of type typeName and loads and
+// validates its config from the environment.
+func configure(name, typeName string) (*Instance, error) {
+	instance, err := NewInstance(name, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, errors2.Join(instance.LoadEnv(), instance.Validate())
+}
+
+func TestInstanceEnv(t *testing.T) {
+	t.Setenv("TEST_COOKIES", "a=b")
+	t.Setenv("TEST_ROOMS", "1, 2")
+	t.Setenv("TEST_INTERVAL", "10m")
+	t.Setenv("SENTRY_KEYWORDS", "哨兵,sentry")
+	t.Setenv("SENTRY_OFFICIAL", "true")
+
+	instance, err := configure("test", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := instance.Config.(*testConfig)
+	if strings.Join(config.Keywords, ",") != "RoboMaster" || config.MaxPages != 5 || config.Interval != 10*time.Minute {
+		t.Fatalf("unexpected config: %+v", config)
+	}
+	if len(config.Rooms) != 2 || config.Rooms[1] != 2 {
+		t.Fatalf("unexpected rooms: %v", config.Rooms)
+	}
+
+	// Instances read their own variables first and fall back to the type's.
+	instance, err = configure("sentry", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sentry := instance.Config.(*testConfig)
+	if strings.Join(sentry.Keywords, ",") != "哨兵,sentry" || !sentry.Official || sentry.Cookies != "a=b" {
+		t.Fatalf("unexpected sentry config: %+v", sentry)
+	}
+
+	p, err := instance.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name() != "sentry" {
+		t.Fatalf("unexpected provider name: %s", p.Name())
+	}
+}
+
+func TestInstanceErrors(t *testing.T) {
+	t.Setenv("TEST_MAX_PAGES", "many")
+
+	if _, err := NewInstance("foo", "bar"); err == nil || err.Error() != `foo: unknown provider type "bar"` {
+		t.Errorf("unexpected unknown type error: %v", err)
+	}
+
+	_, err := configure("test", "test")
+	if err == nil {
+		t.Fatal("invalid config is accepted")
+	}
+
+	for _, expected := range []string{
+		"test: invalid TEST_MAX_PAGES",
+		"test: cookies is required",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("missing error %q in:\n%v", expected, err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package job
+
+import (
+	"expvar"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+)
+
+// PartialError is returned by a provider together with the posts it could
+// collect, when some records, or whole feeds and repos, had to be skipped.
+type PartialError struct {
+	Errs []error
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("%d records skipped: %s", len(e.Errs), strings.Join(lo.Map(e.Errs, func(item error, _ int) string {
+		return item.Error()
+	}), "; "))
+}
+
+func (e *PartialError) Unwrap() []error {
+	return e.Errs
+}
+
+// ScanResult counts what a single scan of a provider did.
+type ScanResult struct {
+	Source    string
+	Collected int
+	Skipped   int
+	Stored    int
+	Failed    bool
+}
+
+func (r ScanResult) String() string {
+	return fmt.Sprintf("%s: %d collected, %d skipped, %d stored", r.Source, r.Collected, r.Skipped, r.Stored)
+}
+
+// scanMetrics holds the running totals of scan results per source, published
+// as the "scan" expvar.
+var scanMetrics = expvar.NewMap("scan")
+var scanMetricsMu sync.Mutex
+
+func sourceMetrics(source string) *expvar.Map {
+	scanMetricsMu.Lock()
+	defer scanMetricsMu.Unlock()
+
+	if m, ok := scanMetrics.Get(source).(*expvar.Map); ok {
+		return m
+	}
+
+	m := new(expvar.Map).Init()
+	scanMetrics.Set(source, m)
+	return m
+}
+
+func (r ScanResult) record() {
+	m := sourceMetrics(r.Source)
+	m.Add("scans", 1)
+	m.Add("collected", int64(r.Collected))
+	m.Add("skipped", int64(r.Skipped))
+	m.Add("stored", int64(r.Stored))
+	if r.Failed {
+		m.Add("failures", 1)
+	}
+}
@@ -0,0 +1,78 @@
+package job
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Route sends the posts it matches to the named consumers. Empty criteria
+// match everything; a post matches when it satisfies each criterion set.
+type Route struct {
+	Sources   []string         // source name glob patterns
+	Tags      []string         // any of the tags, case insensitive
+	Authors   []string         // author name glob patterns
+	Titles    []*regexp.Regexp // any of the title patterns
+	Consumers []string
+}
+
+func (r *Route) Match(source string, item Post) bool {
+	if len(r.Sources) > 0 && !matchGlob(r.Sources, source) {
+		return false
+	}
+	if len(r.Tags) > 0 && !lo.SomeBy(item.GetTags(), func(tag string) bool {
+		return lo.ContainsBy(r.Tags, func(want string) bool {
+			return strings.EqualFold(tag, want)
+		})
+	}) {
+		return false
+	}
+	if len(r.Authors) > 0 && !matchGlob(r.Authors, item.GetAuthor()) {
+		return false
+	}
+	if len(r.Titles) > 0 && !lo.SomeBy(r.Titles, func(pattern *regexp.Regexp) bool {
+		return pattern.MatchString(item.GetTitle())
+	}) {
+		return false
+	}
+
+	return true
+}
+
+func matchGlob(patterns []string, s string) bool {
+	return lo.SomeBy(patterns, func(pattern string) bool {
+		ok, _ := path.Match(pattern, s)
+		return ok
+	})
+}
+
+// WithRoute adds a routing rule. Once any route is set, posts are only
+// delivered to the consumers of the routes they match instead of to all.
+func WithRoute(route Route) TvJobOption {
+	return func(j *TvJob) {
+		j.routes = append(j.routes, route)
+	}
+}
+
+// route returns the consumers a post of source is delivered to.
+func (j *TvJob) route(source string, item Post) []MessageConsumer {
+	if len(j.routes) == 0 {
+		return j.consumers
+	}
+
+	names := make(map[string]struct{})
+	for _, route := range j.routes {
+		if route.Match(source, item) {
+			for _, name := range route.Consumers {
+				names[name] = struct{}{}
+			}
+		}
+	}
+
+	return lo.Filter(j.consumers, func(item MessageConsumer, _ int) bool {
+		_, ok := names[item.Name()]
+		return ok
+	})
+}
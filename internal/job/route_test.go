@@ -0,0 +1,66 @@
+package job
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/ent"
+)
+
+type testConsumer struct {
+	name string
+}
+
+func (c *testConsumer) PushMessage(ctx context.Context, videos []Post) error {
+	return nil
+}
+
+func (c *testConsumer) Name() string {
+	return c.name
+}
+
+func TestRoute(t *testing.T) {
+	j := NewTvJob(
+		WithConsumer(&testConsumer{name: "lark"}),
+		WithConsumer(&testConsumer{name: "vision"}),
+		WithConsumer(&testConsumer{name: "mechanical"}),
+	)
+
+	video := storedPost{&ent.Post{Title: "RMUC 2025 自瞄开源", Tags: []string{"RoboMaster", "视觉"}, Author: "上海交通大学"}}
+	answer := storedPost{&ent.Post{Title: "发射机构初速上限", Tags: []string{"超级对抗赛"}, Author: "华南理工大学"}}
+
+	routed := func(source string, item Post) []string {
+		return lo.Map(j.route(source, item), func(item MessageConsumer, _ int) string {
+			return item.Name()
+		})
+	}
+
+	// Without routes every post goes to every consumer.
+	if names := routed("bilibili", video); len(names) != 3 {
+		t.Fatalf("unexpected consumers without routes: %v", names)
+	}
+
+	j.With(
+		WithRoute(Route{Sources: []string{"bilibili*"}, Tags: []string{"视觉"}, Consumers: []string{"vision"}}),
+		WithRoute(Route{Sources: []string{"rmbbs", "qflow"}, Consumers: []string{"mechanical"}}),
+		WithRoute(Route{Titles: []*regexp.Regexp{regexp.MustCompile(`(?i)rmuc`)}, Authors: []string{"上海*"}, Consumers: []string{"lark", "vision"}}),
+	)
+
+	for _, c := range []struct {
+		source string
+		item   Post
+		want   []string
+	}{
+		{"bilibili", video, []string{"lark", "vision"}},
+		{"bilibili-sentry", video, []string{"lark", "vision"}},
+		{"feed", video, []string{"lark", "vision"}},
+		{"bilibili", answer, []string{}},
+		{"qflow", answer, []string{"mechanical"}},
+	} {
+		if names := routed(c.source, c.item); !lo.ElementsMatch(names, c.want) {
+			t.Errorf("%s post %q routed to %v, want %v", c.source, c.item.GetTitle(), names, c.want)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package job
 
 import (
 	"context"
+	"maps"
 	"slices"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
@@ -10,6 +12,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/wintbiit/rmtv/ent"
 	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/schema"
+	"github.com/wintbiit/rmtv/utils"
 )
 
 type MessageProvider interface {
@@ -19,86 +23,346 @@ type MessageProvider interface {
 
 type MessageConsumer interface {
 	PushMessage(ctx context.Context, videos []Post) error
+	Name() string
 }
 
-func (j *TvJob) scan(ctx context.Context) error {
-	logrus.Debug("Starting TV scan with providers: %+v", j.providers)
+// IncrementalProvider is implemented by providers that page through their
+// source and can stop once they reach posts published before since.
+type IncrementalProvider interface {
+	MessageProvider
+	CollectSince(since time.Time) ([]Post, error)
+}
 
-	tx, err := j.db.Tx(ctx)
+// BackfillProvider is implemented by incremental providers with a separate
+// page cap for backfilling, which usually reaches much further back than a
+// scan.
+type BackfillProvider interface {
+	IncrementalProvider
+	BackfillSince(since time.Time) ([]Post, error)
+}
+
+// ContentPost is implemented by posts carrying a full HTML body besides
+// their description.
+type ContentPost interface {
+	Post
+	GetContent() string
+}
+
+// ContentProvider is implemented by providers that fetch the full content of
+// a post separately. FetchContent is called for posts not stored yet first,
+// then for stored posts still without content, so a provider may skip posts
+// to stay within its rate limit and have them fetched by later scans.
+type ContentProvider interface {
+	MessageProvider
+	FetchContent(item Post) error
+}
+
+// TrackedPost is implemented by posts of mutable sources. Changes of the
+// snapshot between scans are recorded as revisions of the stored post.
+type TrackedPost interface {
+	Post
+	GetSnapshot() map[string]string
+}
+
+// NotifyingPost is implemented by tracked posts with changes that are always
+// worth an update notification, even when WithNotifyUpdates is off.
+type NotifyingPost interface {
+	TrackedPost
+	NotifyChange(change schema.Change) bool
+}
+
+func (j *TvJob) scan(ctx context.Context, providers []MessageProvider) error {
+	logrus.Debugf("Starting TV scan with providers: %v", lo.Map(providers, func(item MessageProvider, _ int) string {
+		return item.Name()
+	}))
+
+	results := parallel.Map(providers, func(pri MessageProvider, index int) ScanResult {
+		result := j.scanProvider(ctx, pri)
+		result.record()
+		logrus.Debugf("Scanned %v", result)
+		return result
+	})
+
+	if lo.SumBy(results, func(item ScanResult) int {
+		return item.Stored
+	}) == 0 {
+		logrus.Infof("No new videos found")
+	}
+
+	return j.deliver(ctx)
+}
+
+func (j *TvJob) scanProvider(ctx context.Context, p MessageProvider) ScanResult {
+	result := ScanResult{Source: p.Name()}
+
+	messages, err := j.collect(ctx, p)
+	if err != nil {
+		var partial *PartialError
+		if !errors.As(err, &partial) {
+			logrus.Errorf("Failed to collect results from provider %s: %v", p.Name(), err)
+			result.Failed = true
+			return result
+		}
+
+		for _, e := range partial.Errs {
+			logrus.Warnf("Skipped %s record: %v", p.Name(), e)
+		}
+		result.Skipped = len(partial.Errs)
+	}
+	result.Collected = len(messages)
+
+	count, err := j.store(ctx, p, messages, true)
 	if err != nil {
-		return errors.Wrapf(err, "failed to create transaction")
+		logrus.Errorf("Failed to store %s results: %v", p.Name(), err)
+		result.Failed = true
+		return result
 	}
-	defer tx.Rollback()
+	result.Stored = count
 
-	results := lo.Flatten(parallel.Map(j.providers, func(pri MessageProvider, index int) []Post {
-		messages, err := pri.Collect()
-		if err != nil {
-			logrus.Errorf("Failed to collect results from provider %v", err)
-			return nil
+	if committing, ok := p.(CommittingProvider); ok {
+		if err := committing.Commit(ctx); err != nil {
+			logrus.Errorf("Failed to commit %s state: %v", p.Name(), err)
 		}
+	}
+
+	return result
+}
+
+// collect runs an incremental provider from the newest stored post of its
+// source, and any other provider as is.
+func (j *TvJob) collect(ctx context.Context, p MessageProvider) ([]Post, error) {
+	incremental, ok := p.(IncrementalProvider)
+	if !ok {
+		return p.Collect()
+	}
+
+	latest, err := j.db.Post.Query().
+		Where(post.SourceEQ(p.Name())).
+		Order(ent.Desc(post.FieldPubDate)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errors.Wrap(err, "failed to query latest post")
+	}
 
-		messages = lo.UniqBy(messages, func(item Post) string {
+	var since time.Time
+	if latest != nil {
+		since = latest.PubDate
+	}
+
+	return incremental.CollectSince(since)
+}
+
+// store saves new posts and revisions of changed posts of a single provider.
+// When notify is set, deliveries for them are queued in the same transaction.
+func (j *TvJob) store(ctx context.Context, p MessageProvider, messages []Post, notify bool) (int, error) {
+	source := p.Name()
+	messages = lo.UniqBy(messages, func(item Post) string {
+		return item.GetId()
+	})
+
+	existing, err := j.db.Post.Query().
+		Where(post.IDIn(lo.Map(messages, func(item Post, _ int) string {
 			return item.GetId()
+		})...)).
+		All(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to query existing posts")
+	}
+
+	seen := lo.SliceToMap(existing, func(item *ent.Post) (string, *ent.Post) {
+		return item.ID, item
+	})
+
+	fresh := make([]Post, 0, len(messages))
+	known := make([]Post, 0, len(messages))
+	for _, item := range messages {
+		stored, ok := seen[item.GetId()]
+		switch {
+		case !ok:
+			fresh = append(fresh, item)
+		case stored.Source != source:
+			logrus.Warnf("%s post %s conflicts with an existing %s post, skipped", source, item.GetId(), stored.Source)
+		default:
+			known = append(known, item)
+		}
+	}
+
+	if notify && j.lookback > 0 {
+		cutoff := time.Now().Add(-j.lookback)
+		fresh = lo.Filter(fresh, func(item Post, index int) bool {
+			return item.GetPubDate().After(cutoff)
 		})
+	}
 
-		latest, err := tx.Post.Query().
-			Where(post.SourceEQ(pri.Name())).
-			Order(ent.Desc(post.FieldPubDate)).
-			Limit(1).
-			First(ctx)
-		if err != nil && !ent.IsNotFound(err) {
-			logrus.Errorf("Failed to query latest post: %v", err)
-			return nil
+	// Full content is fetched outside the transaction.
+	if cp, ok := p.(ContentProvider); ok {
+		missing := lo.Filter(known, func(item Post, _ int) bool {
+			return seen[item.GetId()].Content == ""
+		})
+		for _, item := range append(slices.Clone(fresh), missing...) {
+			if err := cp.FetchContent(item); err != nil {
+				logrus.Errorf("Failed to fetch content of %s post %s: %v", source, item.GetId(), err)
+			}
 		}
+	}
 
-		if latest != nil {
-			messages = lo.Filter(messages, func(item Post, index int) bool {
-				return item.GetPubDate().After(latest.PubDate)
-			})
+	tx, err := j.db.Tx(ctx)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to create transaction")
+	}
+	defer tx.Rollback()
+
+	updated := 0
+	for _, item := range known {
+		changed, err := j.update(ctx, tx, seen[item.GetId()], item, notify)
+		if err != nil {
+			return 0, err
+		}
+		if changed {
+			updated++
 		}
+	}
+
+	if err := tx.Post.CreateBulk(lo.Map(fresh, func(item Post, index int) *ent.PostCreate {
+		create := tx.Post.Create().
+			SetSource(source).
+			SetID(item.GetId()).
+			SetNillablePicture(item.GetPic()).
+			SetTitle(item.GetTitle()).
+			SetTags(item.GetTags()).
+			SetDescription(item.GetDesc()).
+			SetContent(content(item)).
+			SetContentMarkdown(utils.HTMLToMarkdown(content(item))).
+			SetPubDate(item.GetPubDate()).
+			SetAuthor(item.GetAuthor()).
+			SetAuthorURL(item.GetAuthorUrl()).
+			SetURL(item.GetUrl()).
+			SetExtra(item.GetExtra()).
+			SetType(item.GetType()).
+			SetTypeColor(item.GetTypeColor()).
+			SetExtraText(extraText(item))
+		if tracked, ok := item.(TrackedPost); ok {
+			create = create.SetSnapshot(tracked.GetSnapshot())
+		}
+		return create
+	})...).Exec(ctx); err != nil {
+		return 0, errors.Wrap(err, "failed to create posts")
+	}
 
-		if err := tx.Post.CreateBulk(lo.Map(messages, func(item Post, index int) *ent.PostCreate {
-			return tx.Post.Create().
-				SetSource(pri.Name()).
-				SetID(item.GetId()).
-				SetNillablePicture(item.GetPic()).
-				SetTitle(item.GetTitle()).
-				SetTags(item.GetTags()).
-				SetDescription(item.GetDesc()).
-				SetPubDate(item.GetPubDate()).
-				SetAuthor(item.GetAuthor()).
-				SetAuthorURL(item.GetAuthorUrl()).
-				SetURL(item.GetUrl()).
-				SetExtra(item.GetExtra())
+	if notify {
+		if err := tx.Delivery.CreateBulk(lo.FlatMap(fresh, func(item Post, index int) []*ent.DeliveryCreate {
+			return lo.Map(j.route(source, item), func(consumer MessageConsumer, _ int) *ent.DeliveryCreate {
+				return tx.Delivery.Create().
+					SetPostID(item.GetId()).
+					SetConsumer(consumer.Name())
+			})
 		})...).Exec(ctx); err != nil {
-			logrus.Errorf("Failed to create posts: %v", err)
-			return nil
+			return 0, errors.Wrap(err, "failed to create deliveries")
 		}
+	}
 
-		logrus.Infof("%s found %d new videos", pri.Name(), len(messages))
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrapf(err, "failed to commit transaction")
+	}
 
-		return messages
-	}))
+	logrus.Infof("%s found %d new videos, %d updated", source, len(fresh), updated)
 
-	results = lo.Filter(results, func(item Post, index int) bool {
-		return item != nil
-	})
-	if len(results) == 0 {
-		logrus.Infof("No new videos found")
-		return nil
+	return len(fresh), nil
+}
+
+// update refreshes a stored post from its latest collected version. When
+// the tracked snapshot changed, a revision is recorded and, if enabled, an
+// update notification is queued for the consumers the post is routed to.
+func (j *TvJob) update(ctx context.Context, tx *ent.Tx, stored *ent.Post, item Post, notify bool) (bool, error) {
+	var changes []schema.Change
+	snapshot := stored.Snapshot
+	if tracked, ok := item.(TrackedPost); ok {
+		snapshot = tracked.GetSnapshot()
+		if stored.Snapshot != nil {
+			changes = diff(stored.Snapshot, snapshot)
+		}
 	}
 
-	slices.SortFunc(results, func(a, b Post) int {
-		return int(b.GetPubDate().Unix() - a.GetPubDate().Unix())
-	})
+	// Content is not fetched for every scan, keep what was stored then.
+	description, body := item.GetDesc(), content(item)
+	if body == "" && stored.Content != "" {
+		description, body = stored.Description, stored.Content
+	}
 
-	if err := j.onNewMessage(ctx, results); err != nil {
-		return err
+	if maps.Equal(stored.Snapshot, snapshot) &&
+		lo.FromPtr(stored.Picture) == lo.FromPtr(item.GetPic()) &&
+		stored.Title == item.GetTitle() &&
+		stored.Description == description &&
+		stored.Content == body &&
+		stored.ContentMarkdown == utils.HTMLToMarkdown(body) &&
+		stored.ExtraText == extraText(item) &&
+		slices.Equal(stored.Tags, item.GetTags()) {
+		return false, nil
 	}
 
-	if err := tx.Commit(); err != nil {
-		return errors.Wrapf(err, "failed to commit transaction")
+	if err := tx.Post.UpdateOne(stored).
+		SetNillablePicture(item.GetPic()).
+		SetTitle(item.GetTitle()).
+		SetTags(item.GetTags()).
+		SetDescription(description).
+		SetContent(body).
+		SetContentMarkdown(utils.HTMLToMarkdown(body)).
+		SetExtra(item.GetExtra()).
+		SetExtraText(extraText(item)).
+		SetSnapshot(snapshot).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return false, errors.Wrapf(err, "failed to update post %s", stored.ID)
+	}
+
+	if len(changes) == 0 {
+		return true, nil
+	}
+
+	revision, err := tx.PostRevision.Create().
+		SetPostID(stored.ID).
+		SetChanges(changes).
+		Save(ctx)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create revision of post %s", stored.ID)
+	}
+
+	if !notify || !j.notifyUpdates && !notifyChanges(item, changes) {
+		return true, nil
+	}
+
+	if err := tx.Delivery.CreateBulk(lo.Map(j.route(stored.Source, item), func(consumer MessageConsumer, _ int) *ent.DeliveryCreate {
+		return tx.Delivery.Create().
+			SetPostID(stored.ID).
+			SetRevision(revision).
+			SetConsumer(consumer.Name())
+	})...).Exec(ctx); err != nil {
+		return false, errors.Wrap(err, "failed to create update deliveries")
+	}
+
+	return true, nil
+}
+
+func notifyChanges(item Post, changes []schema.Change) bool {
+	np, ok := item.(NotifyingPost)
+	if !ok {
+		return false
+	}
+
+	return lo.SomeBy(changes, np.NotifyChange)
+}
+
+func diff(prev, next map[string]string) []schema.Change {
+	changes := make([]schema.Change, 0)
+	for _, field := range slices.Sorted(maps.Keys(next)) {
+		if prev[field] != next[field] {
+			changes = append(changes, schema.Change{
+				Field: field,
+				Old:   prev[field],
+				New:   next[field],
+			})
+		}
 	}
 
-	return nil
+	return changes
 }
@@ -0,0 +1,88 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/wintbiit/rmtv/ent/delivery"
+)
+
+// scanAndDeliver scans p and delivers, returning what was stored.
+func scanAndDeliver(t *testing.T, j *TvJob, p MessageProvider) ScanResult {
+	ctx := context.Background()
+	result := j.scanProvider(ctx, p)
+	if result.Failed {
+		t.Fatalf("scan of %s failed", p.Name())
+	}
+	if err := j.deliver(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
+
+func TestStoreLateItem(t *testing.T) {
+	lark := newRecordingConsumer("lark")
+	j := newTestJob(t, WithConsumer(lark))
+	now := time.Now()
+	p := &testProvider{name: "bilibili", posts: []Post{testItem{id: "BV2", date: now}}}
+
+	scanAndDeliver(t, j, p)
+
+	// A search indexing a video late returns it after newer ones.
+	p.posts = []Post{testItem{id: "BV2", date: now}, testItem{id: "BV1", date: now.Add(-48 * time.Hour)}}
+	if result := scanAndDeliver(t, j, p); result.Stored != 1 {
+		t.Fatalf("stored %d posts, want the late one", result.Stored)
+	}
+	if result := scanAndDeliver(t, j, p); result.Stored != 0 {
+		t.Fatalf("stored %d posts again", result.Stored)
+	}
+
+	pushes := lark.pushed("")
+	if len(pushes) != 2 || len(pushes[1]) != 1 || pushes[1][0] != "BV1" {
+		t.Fatalf("unexpected pushes %v", pushes)
+	}
+}
+
+func TestStoreSourceConflict(t *testing.T) {
+	lark := newRecordingConsumer("lark")
+	j := newTestJob(t, WithConsumer(lark))
+	ctx := context.Background()
+
+	scanAndDeliver(t, j, &testProvider{name: "bilibili", posts: []Post{testItem{id: "BV1", title: "RMUC 自瞄开源", date: time.Now()}}})
+
+	// The same id from another source is neither new nor an update.
+	feed := &testProvider{name: "feed", posts: []Post{testItem{id: "BV1", title: "转载：RMUC 自瞄开源", date: time.Now()}}}
+	if result := scanAndDeliver(t, j, feed); result.Stored != 0 {
+		t.Fatalf("stored %d conflicting posts", result.Stored)
+	}
+
+	stored := j.db.Post.GetX(ctx, "BV1")
+	if stored.Source != "bilibili" || stored.Title != "RMUC 自瞄开源" {
+		t.Errorf("conflicting post overwrote the stored one: %s %s", stored.Source, stored.Title)
+	}
+	if count := j.db.Delivery.Query().CountX(ctx); count != 1 {
+		t.Errorf("%d deliveries, want 1", count)
+	}
+	if pushes := lark.pushed(""); len(pushes) != 1 {
+		t.Errorf("unexpected pushes %v", pushes)
+	}
+}
+
+func TestStoreLookback(t *testing.T) {
+	lark := newRecordingConsumer("lark")
+	j := newTestJob(t, WithConsumer(lark), WithLookback(24*time.Hour))
+	ctx := context.Background()
+	p := &testProvider{name: "feed", posts: []Post{
+		testItem{id: "new", date: time.Now()},
+		testItem{id: "old", date: time.Now().Add(-48 * time.Hour)},
+	}}
+
+	if result := scanAndDeliver(t, j, p); result.Stored != 1 {
+		t.Fatalf("stored %d posts, want 1", result.Stored)
+	}
+	if j.db.Post.Query().CountX(ctx) != 1 || j.db.Delivery.Query().Where(delivery.PostID("new")).CountX(ctx) != 1 {
+		t.Fatal("posts older than the lookback were stored")
+	}
+}
@@ -0,0 +1,80 @@
+package job
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Serve keeps the database open and scans each provider on its own interval
+// until ctx is cancelled. A provider is never scanned again before its
+// previous scan has finished, and a scan in progress when ctx is cancelled
+// runs to the end so its pushes are recorded.
+func (j *TvJob) Serve(ctx context.Context) error {
+	if err := j.open(ctx); err != nil {
+		return err
+	}
+	defer j.db.Close()
+
+	j.serve(ctx)
+
+	return nil
+}
+
+func (j *TvJob) serve(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, p := range j.providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			j.loop(ctx, p)
+		}()
+	}
+
+	wg.Wait()
+	logrus.Infof("all providers stopped")
+}
+
+func (j *TvJob) loop(ctx context.Context, p MessageProvider) {
+	interval := j.providerInterval(p.Name())
+	logrus.Infof("scheduling %s every %v", p.Name(), interval)
+
+	scanCtx := context.WithoutCancel(ctx)
+	for {
+		if err := j.scan(scanCtx, []MessageProvider{p}); err != nil {
+			logrus.Errorf("scan %s failed: %v", p.Name(), err)
+		}
+
+		next := j.withJitter(interval)
+		logrus.Debugf("next scan of %s in %v", p.Name(), next)
+
+		timer := time.NewTimer(next)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logrus.Infof("stopping %s: %v", p.Name(), ctx.Err())
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (j *TvJob) providerInterval(name string) time.Duration {
+	if interval, ok := j.intervals[name]; ok {
+		return interval
+	}
+
+	return j.interval
+}
+
+func (j *TvJob) withJitter(interval time.Duration) time.Duration {
+	if j.jitter == 0 {
+		return interval
+	}
+
+	delta := float64(interval) * j.jitter
+	return interval + time.Duration(delta*(2*rand.Float64()-1))
+}
@@ -0,0 +1,135 @@
+package job
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// servedProvider counts its scans, failing the test when two of them
+// overlap. Its first collect blocks until release is closed.
+type servedProvider struct {
+	testProvider
+	started chan struct{}
+	release chan struct{}
+
+	inFlight  atomic.Int32
+	collects  atomic.Int32
+	commits   atomic.Int32
+	cancelled atomic.Bool
+	t         *testing.T
+}
+
+func newServedProvider(t *testing.T, name string, posts ...Post) *servedProvider {
+	release := make(chan struct{})
+	close(release)
+
+	return &servedProvider{
+		testProvider: testProvider{name: name, posts: posts},
+		started:      make(chan struct{}, 1),
+		release:      release,
+		t:            t,
+	}
+}
+
+func (p *servedProvider) Collect() ([]Post, error) {
+	if p.inFlight.Add(1) > 1 {
+		p.t.Errorf("scans of %s overlap", p.name)
+	}
+	defer p.inFlight.Add(-1)
+
+	if p.collects.Add(1) == 1 {
+		p.started <- struct{}{}
+		<-p.release
+	}
+	time.Sleep(time.Millisecond)
+
+	return p.posts, nil
+}
+
+func (p *servedProvider) Commit(ctx context.Context) error {
+	if ctx.Err() != nil {
+		p.cancelled.Store(true)
+	}
+	p.commits.Add(1)
+
+	return nil
+}
+
+func TestServeFinishesScan(t *testing.T) {
+	consumer := newRecordingConsumer("consumer")
+	p := newServedProvider(t, "test", testItem{id: "a", date: time.Now()})
+	p.release = make(chan struct{})
+	j := newTestJob(t, WithInterval(time.Millisecond), WithConsumer(consumer), WithProvider(p))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		j.serve(ctx)
+		close(done)
+	}()
+
+	// Cancel while the first scan is collecting, then let it go on.
+	<-p.started
+	cancel()
+	close(p.release)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve did not stop")
+	}
+
+	// Every scan ran to the end, even the one cancelled midway.
+	if p.commits.Load() == 0 || p.commits.Load() != p.collects.Load() || p.cancelled.Load() {
+		t.Fatalf("unexpected scan: %d collects, %d commits, cancelled %v", p.collects.Load(), p.commits.Load(), p.cancelled.Load())
+	}
+	if stored := j.db.Post.Query().CountX(context.Background()); stored != 1 {
+		t.Fatalf("unexpected stored posts: %d", stored)
+	}
+	if pushed := consumer.pushed(""); len(pushed) != 1 || pushed[0][0] != "a" {
+		t.Fatalf("unexpected pushes: %v", pushed)
+	}
+}
+
+func TestServeIntervals(t *testing.T) {
+	fast := newServedProvider(t, "fast")
+	slow := newServedProvider(t, "slow")
+	j := newTestJob(t,
+		WithInterval(time.Millisecond),
+		WithProviderInterval("slow", time.Hour),
+		WithProvider(fast),
+		WithProvider(slow),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	j.serve(ctx)
+
+	// The fast provider is scanned again and again, never overlapping, while
+	// the slow one waits for its own interval.
+	if fast.collects.Load() < 3 || slow.collects.Load() != 1 {
+		t.Fatalf("unexpected scans: fast %d, slow %d", fast.collects.Load(), slow.collects.Load())
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	j := NewTvJob(WithJitter(0.5))
+
+	seen := make(map[time.Duration]bool)
+	for range 100 {
+		next := j.withJitter(time.Second)
+		if next < 500*time.Millisecond || next > 1500*time.Millisecond {
+			t.Fatalf("jitter out of range: %v", next)
+		}
+		seen[next] = true
+	}
+	if len(seen) < 2 {
+		t.Fatal("intervals are not randomized")
+	}
+
+	if next := NewTvJob(WithJitter(0)).withJitter(time.Second); next != time.Second {
+		t.Fatalf("unexpected interval without jitter: %v", next)
+	}
+}
@@ -0,0 +1,73 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/wintbiit/rmtv/ent"
+)
+
+// Store persists small pieces of provider state across scans and restarts.
+type Store struct {
+	db *ent.Client
+}
+
+// StatefulProvider is implemented by providers that keep state in a Store.
+// The store is set once the job has opened its database.
+type StatefulProvider interface {
+	MessageProvider
+	SetStore(store *Store)
+}
+
+// CommittingProvider is implemented by providers that remember what they
+// collected, such as seen keys or conditional request headers. Commit saves
+// that state once the posts of the last collect are stored, so posts that
+// failed to store are collected again by the next scan.
+type CommittingProvider interface {
+	MessageProvider
+	Commit(ctx context.Context) error
+}
+
+// DatabaseConsumer is implemented by consumers keeping their own tables, such
+// as per-chat subscriptions. The client is set once the job has opened its
+// database.
+type DatabaseConsumer interface {
+	MessageConsumer
+	SetDb(db *ent.Client)
+}
+
+// Get decodes the value stored under key into v and reports whether it exists.
+func (s *Store) Get(ctx context.Context, key string, v any) (bool, error) {
+	state, err := s.db.State.Get(ctx, key)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get state %s", key)
+	}
+
+	if err := json.Unmarshal(state.Value, v); err != nil {
+		return false, errors.Wrapf(err, "failed to decode state %s", key)
+	}
+
+	return true, nil
+}
+
+// Put stores v under key, replacing any previous value.
+func (s *Store) Put(ctx context.Context, key string, v any) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode state %s", key)
+	}
+
+	err = s.db.State.UpdateOneID(key).SetValue(value).Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = s.db.State.Create().SetID(key).SetValue(value).Exec(ctx)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to put state %s", key)
+	}
+
+	return nil
+}
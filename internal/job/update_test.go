@@ -0,0 +1,100 @@
+package job
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/wintbiit/rmtv/ent/postrevision"
+	"github.com/wintbiit/rmtv/ent/schema"
+)
+
+func question(snapshot map[string]string, content string, notify ...string) trackedItem {
+	return trackedItem{
+		testItem: testItem{
+			id:      "qflow-1",
+			title:   "发射机构初速上限",
+			desc:    "以裁判系统为准",
+			content: content,
+			date:    time.Date(2025, 5, 2, 8, 30, 0, 0, time.UTC),
+		},
+		snapshot: snapshot,
+		notify:   notify,
+	}
+}
+
+func TestUpdateRevisions(t *testing.T) {
+	lark := newRecordingConsumer("lark")
+	j := newTestJob(t, WithConsumer(lark))
+	ctx := context.Background()
+	p := &testProvider{name: "qflow", posts: []Post{question(map[string]string{"状态": "待回答"}, "<p>初速上限是多少？</p>")}}
+	scanAndDeliver(t, j, p)
+
+	// Unchanged posts are not touched.
+	scanAndDeliver(t, j, p)
+	if count := j.db.PostRevision.Query().CountX(ctx); count != 0 {
+		t.Fatalf("%d revisions of an unchanged post", count)
+	}
+
+	// Content is fetched once, later scans without it keep the stored one.
+	p.posts = []Post{question(map[string]string{"状态": "已回答", "回答": "30m/s"}, "")}
+	scanAndDeliver(t, j, p)
+
+	stored := j.db.Post.GetX(ctx, "qflow-1")
+	if stored.Content != "<p>初速上限是多少？</p>" || stored.Description != "以裁判系统为准" {
+		t.Errorf("stored content was dropped: %q %q", stored.Content, stored.Description)
+	}
+	if stored.Snapshot["状态"] != "已回答" {
+		t.Errorf("snapshot was not updated: %v", stored.Snapshot)
+	}
+
+	revisions := j.db.PostRevision.Query().Where(postrevision.PostID("qflow-1")).AllX(ctx)
+	if len(revisions) != 1 {
+		t.Fatalf("%d revisions, want 1", len(revisions))
+	}
+	expected := []schema.Change{
+		{Field: "回答", Old: "", New: "30m/s"},
+		{Field: "状态", Old: "待回答", New: "已回答"},
+	}
+	if !slices.Equal(revisions[0].Changes, expected) {
+		t.Errorf("changes = %v, want %v", revisions[0].Changes, expected)
+	}
+
+	// Updates are only recorded without WithNotifyUpdates.
+	if pushes := lark.pushed(""); len(pushes) != 1 {
+		t.Errorf("unexpected pushes %v", pushes)
+	}
+}
+
+func TestUpdateNotifications(t *testing.T) {
+	lark := newRecordingConsumer("lark")
+	j := newTestJob(t, WithConsumer(lark))
+	ctx := context.Background()
+	p := &testProvider{name: "qflow", posts: []Post{question(map[string]string{"状态": "待回答"}, "", "回答")}}
+	scanAndDeliver(t, j, p)
+
+	// Changes of fields the post does not notify stay quiet.
+	p.posts = []Post{question(map[string]string{"状态": "已受理"}, "", "回答")}
+	scanAndDeliver(t, j, p)
+
+	// Notifying changes are pushed as updates even without WithNotifyUpdates.
+	p.posts = []Post{question(map[string]string{"状态": "已回答", "回答": "30m/s"}, "", "回答")}
+	scanAndDeliver(t, j, p)
+
+	if count := j.db.PostRevision.Query().CountX(ctx); count != 2 {
+		t.Fatalf("%d revisions, want 2", count)
+	}
+	pushes := lark.pushed("")
+	if len(pushes) != 2 || !slices.Equal(pushes[1], []string{"qflow-1"}) {
+		t.Fatalf("unexpected pushes %v", pushes)
+	}
+
+	// With WithNotifyUpdates every change is pushed.
+	j.With(WithNotifyUpdates(true))
+	p.posts = []Post{question(map[string]string{"状态": "已关闭", "回答": "30m/s"}, "", "回答")}
+	scanAndDeliver(t, j, p)
+	if pushes := lark.pushed(""); len(pushes) != 3 {
+		t.Fatalf("%d pushes, want the update pushed", len(pushes))
+	}
+}
@@ -0,0 +1,292 @@
+package lark
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/postrevision"
+	"github.com/wintbiit/rmtv/ent/pushedcard"
+	"github.com/wintbiit/rmtv/ent/savedpost"
+	"github.com/wintbiit/rmtv/ent/schema"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+const (
+	refreshTimeout = time.Minute
+	// Lark stops updating messages after 14 days, older cards are not
+	// refreshed anymore.
+	pushedCardTTL = 14 * 24 * time.Hour
+)
+
+type cardActionEvent struct {
+	Operator struct {
+		OpenId string `json:"open_id"`
+	} `json:"operator"`
+	Action struct {
+		Value  cardAction `json:"value"`
+		Tag    string     `json:"tag"`
+		Option string     `json:"option"`
+	} `json:"action"`
+	Context struct {
+		OpenMessageId string `json:"open_message_id"`
+		OpenChatId    string `json:"open_chat_id"`
+	} `json:"context"`
+}
+
+func toast(kind, content string) map[string]any {
+	return map[string]any{
+		"toast": map[string]string{
+			"type":    kind,
+			"content": content,
+		},
+	}
+}
+
+// onCardAction applies an action of an action card to the chat and updates
+// the card in place once the toast is answered.
+func (c *Client) onCardAction(ctx context.Context, header *EventHeader, event *cardActionEvent) (any, error) {
+	chat := event.Context.OpenChatId
+	action := event.Action.Value
+	var tag string
+	if event.Action.Option != "" {
+		action.Action, tag, _ = strings.Cut(event.Action.Option, ":")
+	}
+	if chat == "" || action.Post == "" || c.db == nil {
+		return toast("error", "不支持的操作"), nil
+	}
+
+	message, err := c.applyAction(ctx, chat, event.Operator.OpenId, action, tag)
+	if err != nil {
+		logrus.Errorf("failed to apply card action %s of chat %s: %v", action.Action, chat, err)
+		return toast("error", "操作失败，请稍后重试"), nil
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		defer cancel()
+
+		if err := c.refreshCard(ctx, chat, event.Context.OpenMessageId); err != nil {
+			logrus.Errorf("failed to refresh card %s: %v", event.Context.OpenMessageId, err)
+		}
+	}()
+
+	return toast("success", message), nil
+}
+
+func (c *Client) applyAction(ctx context.Context, chat, operator string, action cardAction, tag string) (string, error) {
+	switch action.Action {
+	case actionSave:
+		err := c.db.SavedPost.Create().
+			SetChatID(chat).
+			SetPostID(action.Post).
+			SetSavedBy(operator).
+			Exec(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return "", errors.Wrap(err, "failed to save post")
+		}
+		return "已收藏，发送 /saved 查看本群收藏", nil
+	case actionUnsave:
+		if _, err := c.db.SavedPost.Delete().
+			Where(savedpost.ChatIDEQ(chat), savedpost.PostIDEQ(action.Post)).
+			Exec(ctx); err != nil {
+			return "", errors.Wrap(err, "failed to unsave post")
+		}
+		return "已取消收藏", nil
+	}
+
+	sub, err := c.subscription(ctx, chat)
+	if err != nil {
+		return "", err
+	}
+
+	var message string
+	switch action.Action {
+	case actionMuteAuthor:
+		sub.MutedAuthors = lo.Union(sub.MutedAuthors, []string{action.Author})
+		message = "本群不再接收 " + action.Author + " 的内容"
+	case actionUnmuteAuthor:
+		sub.MutedAuthors = lo.Without(sub.MutedAuthors, action.Author)
+		message = "已取消屏蔽 " + action.Author
+	case actionMuteTag:
+		sub.MutedTags = lo.Union(sub.MutedTags, []string{tag})
+		message = "本群不再接收标签 " + tag + " 的内容"
+	case actionUnmuteTag:
+		sub.MutedTags = lo.Without(sub.MutedTags, tag)
+		message = "已取消屏蔽标签 " + tag
+	case actionMuteSource:
+		sub.MutedSources = lo.Union(sub.MutedSources, []string{action.Source})
+		message = "本群不再接收来源 " + action.Source + " 的内容"
+	case actionUnmuteSource:
+		sub.MutedSources = lo.Without(sub.MutedSources, action.Source)
+		message = "已取消屏蔽来源 " + action.Source
+	default:
+		return "", errors.Errorf("unknown action %q", action.Action)
+	}
+
+	if err := c.save(ctx, sub); err != nil {
+		return "", err
+	}
+
+	return message, nil
+}
+
+// pushCard pushes an action card of posts to a chat and records the posts of
+// its message, to rebuild the card after an action. Failing to record them
+// only keeps the card from being refreshed.
+func (c *Client) pushCard(ctx context.Context, chat, content string, posts []job.Post) error {
+	message, err := c.createMessage(ctx, chat, content)
+	if err != nil {
+		return err
+	}
+	if c.db == nil || c.template != nil || message == "" {
+		return nil
+	}
+
+	if err := c.recordCard(ctx, chat, message, posts); err != nil {
+		logrus.Warnf("failed to record card %s of chat %s: %v", message, chat, err)
+	}
+
+	return nil
+}
+
+// recordCard records the posts of the card pushed as message, with the
+// revisions of update notifications.
+func (c *Client) recordCard(ctx context.Context, chat, message string, posts []job.Post) error {
+	if _, err := c.db.PushedCard.Delete().
+		Where(pushedcard.CreatedAtLT(time.Now().Add(-pushedCardTTL))).
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to prune pushed cards")
+	}
+
+	// Lark answers a retried push with the message created before.
+	err := c.db.PushedCard.Create().
+		SetID(message).
+		SetChatID(chat).
+		SetPosts(lo.Map(posts, func(item job.Post, _ int) schema.CardPost {
+			card := schema.CardPost{PostId: item.GetId()}
+			if updated, ok := item.(job.UpdatedPost); ok {
+				card.RevisionId = updated.GetRevisionId()
+			}
+			return card
+		})).
+		Exec(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return errors.Wrap(err, "failed to save pushed card")
+	}
+
+	return nil
+}
+
+// refreshCard rebuilds the action card of message with the current state of
+// the chat.
+func (c *Client) refreshCard(ctx context.Context, chat, message string) error {
+	card, err := c.rebuildCard(ctx, chat, message)
+	if err != nil || card == nil {
+		return err
+	}
+
+	content, _ := json.Marshal(card)
+	resp, err := c.client.Im.V1.Message.Patch(ctx, larkim.NewPatchMessageReqBuilder().
+		MessageId(message).
+		Body(larkim.NewPatchMessageReqBodyBuilder().
+			Content(string(content)).
+			Build()).
+		Build())
+	if err != nil {
+		return errors.Wrap(err, "failed to patch message")
+	}
+
+	if !resp.Success() {
+		return errors.Wrap(resp, "failed to patch message")
+	}
+
+	return nil
+}
+
+// rebuildCard builds the card recorded for message again, nil when it was
+// not recorded or its posts are gone.
+func (c *Client) rebuildCard(ctx context.Context, chat, message string) (*Card, error) {
+	pushed, err := c.db.PushedCard.Get(ctx, message)
+	if ent.IsNotFound(err) {
+		logrus.Debugf("card %s was not recorded, not refreshed", message)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pushed card")
+	}
+
+	ids := lo.Map(pushed.Posts, func(item schema.CardPost, _ int) string {
+		return item.PostId
+	})
+	stored, err := c.db.Post.Query().
+		Where(post.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query posts")
+	}
+
+	revisions, err := c.db.PostRevision.Query().
+		Where(postrevision.IDIn(lo.FilterMap(pushed.Posts, func(item schema.CardPost, _ int) (int, bool) {
+			return item.RevisionId, item.RevisionId != 0
+		})...)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query revisions")
+	}
+
+	byId := lo.SliceToMap(stored, func(item *ent.Post) (string, *ent.Post) {
+		return item.ID, item
+	})
+	revisionById := lo.SliceToMap(revisions, func(item *ent.PostRevision) (int, *ent.PostRevision) {
+		return item.ID, item
+	})
+	posts := lo.FilterMap(pushed.Posts, func(card schema.CardPost, _ int) (job.Post, bool) {
+		item, ok := byId[card.PostId]
+		if !ok {
+			return nil, false
+		}
+		if revision, ok := revisionById[card.RevisionId]; ok {
+			return job.NewUpdatedPost(item, revision), true
+		}
+		return job.NewStoredPost(item), true
+	})
+	if len(posts) == 0 {
+		return nil, nil
+	}
+
+	state, err := c.cardState(ctx, chat, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCard(posts, c.uploadImages(ctx, posts), true, state), nil
+}
+
+func (c *Client) cardState(ctx context.Context, chat string, ids []string) (cardState, error) {
+	sub, err := c.subscription(ctx, chat)
+	if err != nil {
+		return cardState{}, err
+	}
+
+	saved, err := c.db.SavedPost.Query().
+		Where(savedpost.ChatIDEQ(chat), savedpost.PostIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return cardState{}, errors.Wrap(err, "failed to query saved posts")
+	}
+
+	return cardState{
+		sub: sub,
+		saved: lo.SliceToMap(saved, func(item *ent.SavedPost) (string, bool) {
+			return item.PostID, true
+		}),
+	}, nil
+}
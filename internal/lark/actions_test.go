@@ -0,0 +1,162 @@
+package lark
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/schema"
+	"github.com/wintbiit/rmtv/internal/job"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestDbClient returns a client keeping its tables in a fresh in-memory
+// database.
+func newTestDbClient(t *testing.T) *Client {
+	drv, err := sql.Open(dialect.SQLite, "file::memory:?_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection would open a database of its own.
+	drv.DB().SetMaxOpenConns(1)
+
+	db := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() {
+		db.Close()
+	})
+	if err := db.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Client{}
+	c.SetDb(db)
+
+	return c
+}
+
+func createPost(t *testing.T, c *Client, source, id, author string) *ent.Post {
+	return c.db.Post.Create().
+		SetSource(source).
+		SetID(id).
+		SetTitle(id).
+		SetDescription("").
+		SetTags([]string{"视觉"}).
+		SetPubDate(time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)).
+		SetAuthor(author).
+		SetAuthorURL("").
+		SetURL("https://example.com/" + id).
+		SetExtra(map[string]any{}).
+		SaveX(context.Background())
+}
+
+func TestApplyActionSave(t *testing.T) {
+	c := newTestDbClient(t)
+	ctx := context.Background()
+	createPost(t, c, "bilibili", "BV1", "上海交通大学")
+	save := cardAction{Action: actionSave, Post: "BV1"}
+	unsave := cardAction{Action: actionUnsave, Post: "BV1"}
+
+	// Saving twice, as when a click is retried, keeps a single save.
+	for range 2 {
+		if _, err := c.applyAction(ctx, "oc_1", "ou_1", save, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count := c.db.SavedPost.Query().CountX(ctx); count != 1 {
+		t.Fatalf("%d saved posts, want 1", count)
+	}
+
+	for range 2 {
+		if _, err := c.applyAction(ctx, "oc_1", "ou_1", unsave, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count := c.db.SavedPost.Query().CountX(ctx); count != 0 {
+		t.Fatalf("%d saved posts after unsave", count)
+	}
+}
+
+func TestApplyActionMute(t *testing.T) {
+	c := newTestDbClient(t)
+	ctx := context.Background()
+	createPost(t, c, "bilibili", "BV1", "上海交通大学")
+	value := func(action string) cardAction {
+		return cardAction{Action: action, Post: "BV1", Author: "上海交通大学", Source: "bilibili"}
+	}
+
+	// Muted authors, sources and tags after each action.
+	for _, step := range []struct {
+		action string
+		tag    string
+		want   [3]string
+	}{
+		{actionMuteAuthor, "", [3]string{"上海交通大学", "", ""}},
+		{actionMuteSource, "", [3]string{"上海交通大学", "bilibili", ""}},
+		{actionMuteTag, "视觉", [3]string{"上海交通大学", "bilibili", "视觉"}},
+		{actionMuteTag, "视觉", [3]string{"上海交通大学", "bilibili", "视觉"}},
+		{actionUnmuteAuthor, "", [3]string{"", "bilibili", "视觉"}},
+		{actionUnmuteSource, "", [3]string{"", "", "视觉"}},
+		{actionUnmuteTag, "视觉", [3]string{"", "", ""}},
+	} {
+		if _, err := c.applyAction(ctx, "oc_1", "ou_1", value(step.action), step.tag); err != nil {
+			t.Fatal(err)
+		}
+
+		sub := c.db.Subscription.GetX(ctx, "oc_1")
+		got := [3]string{strings.Join(sub.MutedAuthors, ","), strings.Join(sub.MutedSources, ","), strings.Join(sub.MutedTags, ",")}
+		if got != step.want {
+			t.Errorf("%s %s: muted %v, want %v", step.action, step.tag, got, step.want)
+		}
+	}
+
+	if _, err := c.applyAction(ctx, "oc_1", "ou_1", value("unknown"), ""); err == nil {
+		t.Error("unknown action is applied")
+	}
+}
+
+func TestRebuildCard(t *testing.T) {
+	c := newTestDbClient(t)
+	ctx := context.Background()
+	video := createPost(t, c, "bilibili", "BV1", "上海交通大学")
+	answer := createPost(t, c, "qflow", "qflow-1", "")
+	revision := c.db.PostRevision.Create().
+		SetPostID(answer.ID).
+		SetChanges([]schema.Change{{Field: "回答", New: "30m/s"}}).
+		SaveX(ctx)
+
+	if card, err := c.rebuildCard(ctx, "oc_1", "om_unknown"); err != nil || card != nil {
+		t.Fatalf("rebuilt unrecorded card: %v %v", card, err)
+	}
+
+	posts := []job.Post{job.NewStoredPost(video), job.NewUpdatedPost(answer, revision)}
+	if err := c.recordCard(ctx, "oc_1", "om_1", posts); err != nil {
+		t.Fatal(err)
+	}
+	// Recording the message of a retried push again is fine.
+	if err := c.recordCard(ctx, "oc_1", "om_1", posts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.applyAction(ctx, "oc_1", "ou_1", cardAction{Action: actionSave, Post: "qflow-1"}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	card, err := c.rebuildCard(ctx, "oc_1", "om_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(card)
+	content := string(data)
+
+	// The update notification is rebuilt as such, with the saved state.
+	for _, expected := range []string{"qflow 更新", "**回答**: - → 30m/s", "已收藏，取消", `"action":"save","post":"BV1"`} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("rebuilt card misses %q:\n%s", expected, content)
+		}
+	}
+}
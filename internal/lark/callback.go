@@ -0,0 +1,287 @@
+package lark
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Lark retries undelivered events for a few hours.
+	eventDedupeTTL = 6 * time.Hour
+	// Events older than the dedupe window could not be told from replays.
+	maxEventAge  = eventDedupeTTL
+	maxClockSkew = time.Minute
+	maxEventSize = 1 << 20
+)
+
+// EventHeader is the header of a Lark event of schema 2.0.
+type EventHeader struct {
+	EventId    string `json:"event_id"`
+	EventType  string `json:"event_type"`
+	CreateTime string `json:"create_time"`
+	Token      string `json:"token"`
+	AppId      string `json:"app_id"`
+	TenantKey  string `json:"tenant_key"`
+}
+
+// EventFunc handles the raw event of a type. A non nil response is written
+// back as JSON, e.g. the toast or card answering a card action.
+type EventFunc func(ctx context.Context, header *EventHeader, event json.RawMessage) (any, error)
+
+// EventHandler serves the Lark event callback. It answers the URL
+// verification challenge, verifies signatures and the verification token,
+// decrypts encrypted payloads, drops redelivered and stale events and
+// dispatches the rest to the handler registered for their type. Without a
+// verification token or encrypt key every request is refused.
+type EventHandler struct {
+	verificationToken string
+	encryptKey        string
+	handlers          map[string]EventFunc
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func NewEventHandler(verificationToken, encryptKey string) *EventHandler {
+	return &EventHandler{
+		verificationToken: verificationToken,
+		encryptKey:        encryptKey,
+		handlers:          make(map[string]EventFunc),
+		seen:              make(map[string]time.Time),
+	}
+}
+
+// Handle registers f for events of eventType, replacing any previous one.
+func (h *EventHandler) Handle(eventType string, f EventFunc) {
+	h.handlers[eventType] = f
+}
+
+// On registers a handler receiving events of eventType decoded into T.
+func On[T any](h *EventHandler, eventType string, f func(ctx context.Context, header *EventHeader, event *T) (any, error)) {
+	h.Handle(eventType, func(ctx context.Context, header *EventHeader, raw json.RawMessage) (any, error) {
+		event := new(T)
+		if err := json.Unmarshal(raw, event); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s event", eventType)
+		}
+		return f(ctx, header, event)
+	})
+}
+
+type eventRequest struct {
+	Encrypt string `json:"encrypt"`
+
+	// URL verification
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Token     string `json:"token"`
+
+	Schema string          `json:"schema"`
+	Header *EventHeader    `json:"header"`
+	Event  json.RawMessage `json:"event"`
+}
+
+func (h *EventHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxEventSize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	status, resp, err := h.serve(r.Context(), r.Header, body)
+	if err != nil {
+		logrus.Warnf("lark event: %v", err)
+	}
+	if resp == nil {
+		resp = struct{}{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *EventHandler) serve(ctx context.Context, header http.Header, body []byte) (int, any, error) {
+	if h.verificationToken == "" && h.encryptKey == "" {
+		return http.StatusUnauthorized, nil, errors.New("no verification token or encrypt key configured")
+	}
+
+	var req eventRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return http.StatusBadRequest, nil, errors.Wrap(err, "invalid body")
+	}
+
+	if req.Encrypt != "" {
+		if h.encryptKey == "" {
+			return http.StatusBadRequest, nil, errors.New("encrypted event without encrypt key")
+		}
+		plain, err := decrypt(req.Encrypt, h.encryptKey)
+		if err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+		req = eventRequest{}
+		if err := json.Unmarshal(plain, &req); err != nil {
+			return http.StatusBadRequest, nil, errors.Wrap(err, "invalid decrypted body")
+		}
+	}
+
+	// The challenge is not signed, only its token is checked.
+	if req.Type == "url_verification" {
+		if !h.checkToken(req.Token) {
+			return http.StatusUnauthorized, nil, errors.New("invalid verification token")
+		}
+		return http.StatusOK, map[string]string{"challenge": req.Challenge}, nil
+	}
+
+	if h.encryptKey != "" && !h.verify(header, body) {
+		return http.StatusUnauthorized, nil, errors.New("invalid signature")
+	}
+
+	if req.Schema != "2.0" || req.Header == nil {
+		return http.StatusBadRequest, nil, errors.New("unsupported event schema")
+	}
+	if !h.checkToken(req.Header.Token) {
+		return http.StatusUnauthorized, nil, errors.New("invalid verification token")
+	}
+	if err := checkCreateTime(req.Header.CreateTime, time.Now()); err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
+	f, ok := h.handlers[req.Header.EventType]
+	if !ok {
+		logrus.Debugf("lark event: no handler for %s", req.Header.EventType)
+		return http.StatusOK, nil, nil
+	}
+
+	if !h.first(req.Header.EventId) {
+		logrus.Debugf("lark event: dropped redelivered %s %s", req.Header.EventType, req.Header.EventId)
+		return http.StatusOK, nil, nil
+	}
+
+	resp, err := f(ctx, req.Header, req.Event)
+	if err != nil {
+		// Lark retries failed events, let it deliver this one again.
+		h.forget(req.Header.EventId)
+		return http.StatusInternalServerError, nil, errors.Wrapf(err, "failed to handle %s", req.Header.EventType)
+	}
+
+	return http.StatusOK, resp, nil
+}
+
+func (h *EventHandler) checkToken(token string) bool {
+	return h.verificationToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.verificationToken)) == 1
+}
+
+// checkCreateTime refuses events created outside the window in which
+// redelivered events are recognized, so they cannot be replayed later.
+func checkCreateTime(createTime string, now time.Time) error {
+	ms, err := strconv.ParseInt(createTime, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid event create time %q", createTime)
+	}
+
+	created := time.UnixMilli(ms)
+	if now.Sub(created) > maxEventAge || created.Sub(now) > maxClockSkew {
+		return errors.Errorf("stale event created at %s", created.Format(time.DateTime))
+	}
+
+	return nil
+}
+
+// verify checks the X-Lark-Signature of the body, the hex sha256 of the
+// timestamp, nonce, encrypt key and body.
+func (h *EventHandler) verify(header http.Header, body []byte) bool {
+	expected := sign(header.Get("X-Lark-Request-Timestamp"), header.Get("X-Lark-Request-Nonce"), h.encryptKey, body)
+	return subtle.ConstantTimeCompare([]byte(header.Get("X-Lark-Signature")), []byte(expected)) == 1
+}
+
+func sign(timestamp, nonce, encryptKey string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(timestamp + nonce + encryptKey))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// decrypt decodes a payload encrypted with AES-256-CBC, keyed with the sha256
+// of the encrypt key and prefixed with its IV.
+func decrypt(encrypted, encryptKey string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid encrypted body")
+	}
+	if len(data) < 2*aes.BlockSize || len(data)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid encrypted body length")
+	}
+
+	key := sha256.Sum256([]byte(encryptKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	iv, data := data[:aes.BlockSize], data[aes.BlockSize:]
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(data, data)
+
+	padding := int(data[len(data)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, errors.New("invalid encrypted body padding")
+	}
+	for _, b := range data[len(data)-padding:] {
+		if int(b) != padding {
+			return nil, errors.New("invalid encrypted body padding")
+		}
+	}
+
+	return data[:len(data)-padding], nil
+}
+
+// first reports whether the event is seen for the first time, forgetting
+// events older than eventDedupeTTL. Events without an id cannot be told
+// apart and are always handled.
+func (h *EventHandler) first(id string) bool {
+	if id == "" {
+		return true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	for seen, at := range h.seen {
+		if now.Sub(at) > eventDedupeTTL {
+			delete(h.seen, seen)
+		}
+	}
+
+	if _, ok := h.seen[id]; ok {
+		return false
+	}
+	h.seen[id] = now
+
+	return true
+}
+
+func (h *EventHandler) forget(id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.seen, id)
+}
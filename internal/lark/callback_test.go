@@ -0,0 +1,199 @@
+package lark
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	testToken      = "v-token"
+	testEncryptKey = "e-key"
+)
+
+func encrypt(t *testing.T, plain []byte) []byte {
+	padding := aes.BlockSize - len(plain)%aes.BlockSize
+	return encryptPadded(t, append(plain, bytes.Repeat([]byte{byte(padding)}, padding)...))
+}
+
+// encryptPadded encrypts plain, which is already padded to the block size.
+func encryptPadded(t *testing.T, plain []byte) []byte {
+	key := sha256.Sum256([]byte(testEncryptKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := make([]byte, aes.BlockSize+len(plain))
+	rand.Read(data[:aes.BlockSize])
+	cipher.NewCBCEncrypter(block, data[:aes.BlockSize]).CryptBlocks(data[aes.BlockSize:], plain)
+
+	body, _ := json.Marshal(map[string]string{"encrypt": base64.StdEncoding.EncodeToString(data)})
+	return body
+}
+
+func event(t *testing.T, id, eventType, token string, payload any) []byte {
+	return eventAt(t, id, eventType, token, time.Now(), payload)
+}
+
+func eventAt(t *testing.T, id, eventType, token string, created time.Time, payload any) []byte {
+	plain, _ := json.Marshal(map[string]any{
+		"schema": "2.0",
+		"header": EventHeader{
+			EventId:    id,
+			EventType:  eventType,
+			Token:      token,
+			CreateTime: strconv.FormatInt(created.UnixMilli(), 10),
+		},
+		"event": payload,
+	})
+	return encrypt(t, plain)
+}
+
+func send(h http.Handler, body []byte, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/lark/event", bytes.NewReader(body))
+	req.Header.Set("X-Lark-Request-Timestamp", "1700000000")
+	req.Header.Set("X-Lark-Request-Nonce", "nonce")
+	if signature == "" {
+		signature = sign("1700000000", "nonce", testEncryptKey, body)
+	}
+	req.Header.Set("X-Lark-Signature", signature)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+type testEvent struct {
+	ChatId string `json:"chat_id"`
+}
+
+func TestEventHandler(t *testing.T) {
+	h := NewEventHandler(testToken, testEncryptKey)
+
+	var handled []string
+	On(h, "im.chat.member.bot.added_v1", func(ctx context.Context, header *EventHeader, event *testEvent) (any, error) {
+		handled = append(handled, header.EventId+":"+event.ChatId)
+		if event.ChatId == "oc_fail" {
+			return nil, errors.New("failed")
+		}
+		return map[string]string{"chat": event.ChatId}, nil
+	})
+
+	challenge, _ := json.Marshal(map[string]string{"type": "url_verification", "challenge": "c1", "token": testToken})
+	if w := send(h, encrypt(t, challenge), "unsigned"); w.Code != http.StatusOK || w.Body.String() != "{\"challenge\":\"c1\"}\n" {
+		t.Fatalf("unexpected challenge response %d %s", w.Code, w.Body)
+	}
+
+	body := event(t, "e1", "im.chat.member.bot.added_v1", testToken, testEvent{ChatId: "oc_1"})
+	if w := send(h, body, ""); w.Code != http.StatusOK || w.Body.String() != "{\"chat\":\"oc_1\"}\n" {
+		t.Fatalf("unexpected event response %d %s", w.Code, w.Body)
+	}
+
+	// Redelivered events are acknowledged without being handled again.
+	if w := send(h, body, ""); w.Code != http.StatusOK {
+		t.Fatalf("unexpected redelivery response %d %s", w.Code, w.Body)
+	}
+
+	for _, c := range []struct {
+		name   string
+		body   []byte
+		sig    string
+		status int
+	}{
+		{"bad signature", event(t, "e2", "im.chat.member.bot.added_v1", testToken, testEvent{ChatId: "oc_2"}), "forged", http.StatusUnauthorized},
+		{"bad token", event(t, "e3", "im.chat.member.bot.added_v1", "forged", testEvent{ChatId: "oc_3"}), "", http.StatusUnauthorized},
+		{"bad payload", []byte(`{"encrypt":"bm90IGVuY3J5cHRlZA=="}`), "", http.StatusBadRequest},
+		{"stale event", eventAt(t, "e6", "im.chat.member.bot.added_v1", testToken, time.Now().Add(-7*time.Hour), testEvent{ChatId: "oc_6"}), "", http.StatusBadRequest},
+		{"future event", eventAt(t, "e7", "im.chat.member.bot.added_v1", testToken, time.Now().Add(time.Hour), testEvent{ChatId: "oc_7"}), "", http.StatusBadRequest},
+		{"unknown event", event(t, "e4", "im.message.read_v1", testToken, testEvent{}), "", http.StatusOK},
+		{"failed event", event(t, "e5", "im.chat.member.bot.added_v1", testToken, testEvent{ChatId: "oc_fail"}), "", http.StatusInternalServerError},
+	} {
+		if w := send(h, c.body, c.sig); w.Code != c.status {
+			t.Errorf("%s: unexpected response %d %s", c.name, w.Code, w.Body)
+		}
+	}
+
+	// Failed events are handled again when Lark retries them.
+	send(h, event(t, "e5", "im.chat.member.bot.added_v1", testToken, testEvent{ChatId: "oc_fail"}), "")
+
+	// Events without an id are not taken for redeliveries of each other.
+	send(h, event(t, "", "im.chat.member.bot.added_v1", testToken, testEvent{ChatId: "oc_8"}), "")
+	send(h, event(t, "", "im.chat.member.bot.added_v1", testToken, testEvent{ChatId: "oc_9"}), "")
+
+	expected := []string{"e1:oc_1", "e5:oc_fail", "e5:oc_fail", ":oc_8", ":oc_9"}
+	if !slices.Equal(handled, expected) {
+		t.Fatalf("unexpected handled events: %v", handled)
+	}
+}
+
+func TestEventHandlerUnconfigured(t *testing.T) {
+	h := NewEventHandler("", "")
+	On(h, "im.message.receive_v1", func(ctx context.Context, header *EventHeader, event *testEvent) (any, error) {
+		t.Fatal("event handled without a verification token or encrypt key")
+		return nil, nil
+	})
+
+	body, _ := json.Marshal(map[string]any{
+		"schema": "2.0",
+		"header": EventHeader{EventId: "e1", EventType: "im.message.receive_v1", CreateTime: strconv.FormatInt(time.Now().UnixMilli(), 10)},
+		"event":  testEvent{},
+	})
+	if w := send(h, body, "unsigned"); w.Code != http.StatusUnauthorized {
+		t.Fatalf("unexpected response %d %s", w.Code, w.Body)
+	}
+}
+
+func TestDecryptPadding(t *testing.T) {
+	// The last byte claims two bytes of padding, the one before it differs.
+	plain := append([]byte(`{"type":"x"}`), 5, 5, 1, 2)
+	var body struct {
+		Encrypt string `json:"encrypt"`
+	}
+	json.Unmarshal(encryptPadded(t, plain), &body)
+
+	if _, err := decrypt(body.Encrypt, testEncryptKey); err == nil {
+		t.Fatal("invalid padding accepted")
+	}
+}
+
+func TestEventHandlerPlain(t *testing.T) {
+	h := NewEventHandler(testToken, "")
+
+	handled := 0
+	On(h, "im.message.receive_v1", func(ctx context.Context, header *EventHeader, event *testEvent) (any, error) {
+		handled++
+		return nil, nil
+	})
+
+	body, _ := json.Marshal(map[string]any{
+		"schema": "2.0",
+		"header": EventHeader{
+			EventId:    "e1",
+			EventType:  "im.message.receive_v1",
+			Token:      testToken,
+			CreateTime: strconv.FormatInt(time.Now().UnixMilli(), 10),
+		},
+		"event": testEvent{},
+	})
+	if w := send(h, body, "unsigned"); w.Code != http.StatusOK || w.Body.String() != "{}\n" || handled != 1 {
+		t.Fatalf("unexpected response %d %s, handled %d", w.Code, w.Body, handled)
+	}
+
+	if w := send(h, encrypt(t, body), ""); w.Code != http.StatusBadRequest {
+		t.Fatalf("encrypted event accepted without encrypt key: %d", w.Code)
+	}
+}
@@ -3,21 +3,17 @@ package lark
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/pkg/errors"
 	"github.com/samber/lo"
-	"github.com/samber/lo/parallel"
-	"github.com/sirupsen/logrus"
 	"github.com/wintbiit/rmtv/internal/job"
 )
 
 type ChatContent struct {
-	MsgType string    `json:"msg_type"`
-	Card    *ChatCard `json:"card"`
+	MsgType string `json:"msg_type"`
+	Card    any    `json:"card"`
 }
 
 type ChatCard struct {
@@ -28,42 +24,51 @@ type ChatCard struct {
 	} `json:"data"`
 }
 
-const (
-	templateId       = "AAqdTMBQENhuz"
-	templateIdNoImg  = "AAqxTSf0s4wL9"
-	imageKeyFallback = "img_v3_02nc_aa0dfc39-5024-4d47-a9a1-00d99a81a09g"
-)
+// Template selects hosted card templates to push instead of code built cards.
+// The templates must belong to the tenant of the app.
+type Template struct {
+	Id      string
+	IdNoImg string
+	// ImageFallback is shown for posts without a picture.
+	ImageFallback string
+}
 
-var imageUploadClient *Client
+// DefaultTemplate are the templates rmtv used before cards were built in code.
+var DefaultTemplate = Template{
+	Id:            "AAqdTMBQENhuz",
+	IdNoImg:       "AAqxTSf0s4wL9",
+	ImageFallback: "img_v3_02nc_aa0dfc39-5024-4d47-a9a1-00d99a81a09g",
+}
 
-func BuildMessageCard(ctx context.Context, messages []job.Post) (*ChatCard, error) {
-	images := parallel.Map(messages, func(item job.Post, i int) string {
-		url := item.GetPic()
-		if url == nil || imageUploadClient == nil {
-			return imageKeyFallback
-		}
+// NewTemplate returns the hosted templates to push, empty ids and fallback
+// defaulting to DefaultTemplate.
+func NewTemplate(id, idNoImg, imageFallback string) *Template {
+	return &Template{
+		Id:            lo.CoalesceOrEmpty(id, DefaultTemplate.Id),
+		IdNoImg:       lo.CoalesceOrEmpty(idNoImg, DefaultTemplate.IdNoImg),
+		ImageFallback: lo.CoalesceOrEmpty(imageFallback, DefaultTemplate.ImageFallback),
+	}
+}
 
-		r, err := http.Get(*url)
-		if err != nil {
-			logrus.Error(errors.Wrap(err, "failed to get image url"))
-			return imageKeyFallback
-		}
-		defer r.Body.Close()
+// buildMessageCard builds the card pushed for messages, from template if set,
+// with pictures uploaded by uploader.
+func buildMessageCard(ctx context.Context, uploader *Client, template *Template, messages []job.Post, actions bool) any {
+	if template != nil {
+		return BuildTemplateCard(ctx, uploader, *template, messages)
+	}
 
-		imageKey, err := imageUploadClient.uploadImage(ctx, r.Body)
-		if err != nil {
-			logrus.Error(errors.Wrap(err, "lark uploadImage"))
-			return imageKeyFallback
-		}
+	return BuildCard(ctx, uploader, messages, actions)
+}
 
-		return imageKey
-	})
+// BuildTemplateCard fills the hosted template with the posts. A template has
+// a fixed layout, so one post without a picture switches the whole card to
+// the template without pictures, as does a nil uploader.
+func BuildTemplateCard(ctx context.Context, uploader *Client, t Template, messages []job.Post) *ChatCard {
+	images := uploader.uploadImages(ctx, messages)
 
-	template := templateId
-	if lo.ContainsBy(images, func(item string) bool {
-		return item == imageKeyFallback
-	}) {
-		template = templateIdNoImg
+	template := t.Id
+	if lo.Contains(images, "") {
+		template = t.IdNoImg
 	}
 
 	var content ChatCard
@@ -83,9 +88,14 @@ func BuildMessageCard(ctx context.Context, messages []job.Post) (*ChatCard, erro
 				return acc + "<text_tag color='blue'>" + tag + "</text_tag> "
 			}, ""))
 
+			var additional string
+			if extra := item.GetExtra(); extra != nil {
+				additional = extra.String()
+			}
+
 			return map[string]interface{}{
 				"img": map[string]interface{}{
-					"img_key": images[i],
+					"img_key": lo.CoalesceOrEmpty(images[i], t.ImageFallback),
 				},
 				"title":    title.String(),
 				"titleraw": item.GetTitle(),
@@ -96,12 +106,12 @@ func BuildMessageCard(ctx context.Context, messages []job.Post) (*ChatCard, erro
 				"author_url":  item.GetAuthorUrl(),
 				"author":      item.GetAuthor(),
 				"description": item.GetDesc(),
-				"additional":  item.GetExtra().String(),
+				"additional":  additional,
 				"type":        item.GetType(),
 				"color":       item.GetTypeColor(),
 			}
 		}),
 	}
 
-	return &content, nil
+	return &content
 }
@@ -0,0 +1,156 @@
+package lark
+
+import "encoding/json"
+
+// Card is a Lark card of JSON schema 2.0, see
+// https://open.feishu.cn/document/feishu-cards/card-json-v2-structure
+type Card struct {
+	Schema string      `json:"schema"`
+	Config CardConfig  `json:"config"`
+	Header *CardHeader `json:"header,omitempty"`
+	Body   CardBody    `json:"body"`
+}
+
+type CardConfig struct {
+	// UpdateMulti makes updates of the card visible to every member of the
+	// chat, required for cards updated after an action.
+	UpdateMulti bool   `json:"update_multi"`
+	WidthMode   string `json:"width_mode,omitempty"`
+}
+
+type CardHeader struct {
+	Title    Text   `json:"title"`
+	Subtitle *Text  `json:"subtitle,omitempty"`
+	Template string `json:"template,omitempty"`
+}
+
+type CardBody struct {
+	Elements []CardElement `json:"elements"`
+}
+
+// CardElement is a component of a card body.
+type CardElement interface {
+	json.Marshaler
+}
+
+type Text struct {
+	Tag     string `json:"tag"`
+	Content string `json:"content"`
+}
+
+func PlainText(content string) Text {
+	return Text{Tag: "plain_text", Content: content}
+}
+
+// Behavior is what a button or menu does when clicked.
+type Behavior struct {
+	Type       string `json:"type"`
+	DefaultUrl string `json:"default_url,omitempty"`
+	Value      any    `json:"value,omitempty"`
+}
+
+func OpenUrl(url string) Behavior {
+	return Behavior{Type: "open_url", DefaultUrl: url}
+}
+
+// Callback sends value to the card.action.trigger callback.
+func Callback(value any) Behavior {
+	return Behavior{Type: "callback", Value: value}
+}
+
+type Markdown struct {
+	Content  string `json:"content"`
+	TextSize string `json:"text_size,omitempty"`
+}
+
+func (e Markdown) MarshalJSON() ([]byte, error) {
+	type element Markdown
+	return json.Marshal(struct {
+		Tag string `json:"tag"`
+		element
+	}{"markdown", element(e)})
+}
+
+type Image struct {
+	ImgKey    string `json:"img_key"`
+	Alt       Text   `json:"alt"`
+	ScaleType string `json:"scale_type,omitempty"`
+	Size      string `json:"size,omitempty"`
+}
+
+func (e Image) MarshalJSON() ([]byte, error) {
+	type element Image
+	return json.Marshal(struct {
+		Tag string `json:"tag"`
+		element
+	}{"img", element(e)})
+}
+
+type Hr struct{}
+
+func (e Hr) MarshalJSON() ([]byte, error) {
+	return []byte(`{"tag":"hr"}`), nil
+}
+
+type ColumnSet struct {
+	HorizontalSpacing string   `json:"horizontal_spacing,omitempty"`
+	Columns           []Column `json:"columns"`
+}
+
+func (e ColumnSet) MarshalJSON() ([]byte, error) {
+	type element ColumnSet
+	return json.Marshal(struct {
+		Tag string `json:"tag"`
+		element
+	}{"column_set", element(e)})
+}
+
+type Column struct {
+	Width         string        `json:"width"`
+	Weight        int           `json:"weight,omitempty"`
+	VerticalAlign string        `json:"vertical_align,omitempty"`
+	Elements      []CardElement `json:"elements"`
+}
+
+func (e Column) MarshalJSON() ([]byte, error) {
+	type element Column
+	return json.Marshal(struct {
+		Tag string `json:"tag"`
+		element
+	}{"column", element(e)})
+}
+
+type Button struct {
+	Text      Text       `json:"text"`
+	Type      string     `json:"type,omitempty"`
+	Size      string     `json:"size,omitempty"`
+	Behaviors []Behavior `json:"behaviors"`
+}
+
+func (e Button) MarshalJSON() ([]byte, error) {
+	type element Button
+	return json.Marshal(struct {
+		Tag string `json:"tag"`
+		element
+	}{"button", element(e)})
+}
+
+// Overflow is a menu of options. The value of the chosen option is sent to
+// the callback as the action option, along with the value of the menu.
+type Overflow struct {
+	Options []OverflowOption `json:"options"`
+	Value   any              `json:"value,omitempty"`
+}
+
+type OverflowOption struct {
+	Text  Text   `json:"text"`
+	Value string `json:"value"`
+}
+
+func (e Overflow) MarshalJSON() ([]byte, error) {
+	type element Overflow
+	return json.Marshal(struct {
+		Tag string `json:"tag"`
+		element
+	}{"overflow", element(e)})
+}
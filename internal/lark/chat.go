@@ -5,6 +5,7 @@ import (
 
 	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
 	"github.com/pkg/errors"
+	"github.com/wintbiit/rmtv/internal/job"
 )
 
 func (c *Client) ForeachChat(ctx context.Context, f func(*larkim.ListChat)) error {
@@ -32,3 +33,32 @@ func (c *Client) ForeachChat(ctx context.Context, f func(*larkim.ListChat)) erro
 
 	return nil
 }
+
+// ChatClient pushes to the given chats only, instead of every chat the bot
+// is in.
+type ChatClient struct {
+	*Client
+	name  string
+	chats []string
+}
+
+// Chats returns a consumer named name pushing to chats through c.
+func (c *Client) Chats(name string, chats []string) *ChatClient {
+	return &ChatClient{
+		Client: c,
+		name:   name,
+		chats:  chats,
+	}
+}
+
+func (c *ChatClient) Name() string {
+	return c.name
+}
+
+func (c *ChatClient) PushMessage(ctx context.Context, videos []job.Post) error {
+	return c.pushToChats(ctx, c.chats, videos)
+}
+
+func (c *ChatClient) Targets(ctx context.Context) ([]string, error) {
+	return c.chats, nil
+}
@@ -0,0 +1,199 @@
+package lark
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/ent/savedpost"
+)
+
+const commandUsage = `可用命令：
+/subscribe <来源>  只接收指定来源，可多次添加
+/subscribe all  接收全部来源
+/unsubscribe <来源>  不再接收该来源
+/keywords add <关键词>  只接收含任一关键词的内容
+/keywords remove <关键词>  删除关键词
+/keywords clear  清空关键词
+/unmute  取消屏蔽全部作者与标签
+/saved  查看本群收藏
+/status  查看本群订阅`
+
+const savedListLength = 20
+
+var mention = regexp.MustCompile(`@_user_\d+`)
+
+// command runs a bot command sent in a chat and returns the reply. Text not
+// starting with a slash is no command and gets no reply.
+func (c *Client) command(ctx context.Context, chatId, text string) (string, error) {
+	args := strings.Fields(mention.ReplaceAllString(text, ""))
+	if len(args) == 0 || !strings.HasPrefix(args[0], "/") {
+		return "", nil
+	}
+	if c.db == nil {
+		return "", errors.New("subscriptions are not available without a database")
+	}
+
+	sub, err := c.subscription(ctx, chatId)
+	if err != nil {
+		return "", err
+	}
+
+	var reply string
+	switch name, args := args[0], args[1:]; {
+	case name == "/subscribe" && len(args) == 1 && args[0] == "all":
+		sub.Sources, sub.MutedSources = nil, nil
+		reply = "已订阅全部来源"
+	case name == "/subscribe" && len(args) > 0:
+		if unknown, err := c.unknownSources(ctx, args); err != nil || unknown != "" {
+			return unknown, err
+		}
+		sub.Sources = lo.Union(sub.Sources, args)
+		sub.MutedSources = lo.Without(sub.MutedSources, args...)
+		reply = "已订阅 " + strings.Join(args, "、")
+	case name == "/unsubscribe" && len(args) > 0:
+		var ok bool
+		if reply, ok = unsubscribe(sub, args); !ok {
+			return reply, nil
+		}
+	case name == "/keywords" && len(args) > 1 && args[0] == "add":
+		sub.Keywords = lo.Union(sub.Keywords, args[1:])
+		reply = "已添加关键词 " + strings.Join(args[1:], "、")
+	case name == "/keywords" && len(args) > 1 && args[0] == "remove":
+		sub.Keywords = lo.Without(sub.Keywords, args[1:]...)
+		reply = "已删除关键词 " + strings.Join(args[1:], "、")
+	case name == "/keywords" && len(args) == 1 && args[0] == "clear":
+		sub.Keywords = nil
+		reply = "已清空关键词"
+	case name == "/unmute" && len(args) == 0:
+		sub.MutedAuthors, sub.MutedTags = nil, nil
+		reply = "已取消屏蔽全部作者与标签"
+	case name == "/saved" && len(args) == 0:
+		return c.saved(ctx, chatId)
+	case name == "/status":
+		return status(sub), nil
+	default:
+		return commandUsage, nil
+	}
+
+	if err := c.save(ctx, sub); err != nil {
+		return "", err
+	}
+
+	return reply + "\n\n" + status(sub), nil
+}
+
+// unsubscribe stops a chat from receiving sources. A chat subscribed to no
+// source receives all of them, so unsubscribing every subscribed source is
+// refused instead.
+func unsubscribe(sub *ent.Subscription, sources []string) (string, bool) {
+	if len(sub.Sources) > 0 && len(lo.Without(sub.Sources, sources...)) == 0 {
+		return "不能取消订阅全部已订阅来源，否则将接收全部来源。请先订阅其他来源，或发送 /subscribe all 接收全部来源", false
+	}
+
+	sub.Sources = lo.Without(sub.Sources, sources...)
+	sub.MutedSources = lo.Union(sub.MutedSources, sources)
+	return "已取消订阅 " + strings.Join(sources, "、"), true
+}
+
+// subscription returns the subscription of a chat, or an empty one if it
+// has none yet.
+func (c *Client) subscription(ctx context.Context, chatId string) (*ent.Subscription, error) {
+	sub, err := c.db.Subscription.Get(ctx, chatId)
+	if ent.IsNotFound(err) {
+		return &ent.Subscription{ID: chatId}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get subscription of %s", chatId)
+	}
+
+	return sub, nil
+}
+
+func (c *Client) save(ctx context.Context, sub *ent.Subscription) error {
+	orEmpty := func(items []string) []string {
+		return lo.Ternary(items == nil, []string{}, items)
+	}
+
+	err := c.db.Subscription.UpdateOneID(sub.ID).
+		SetSources(orEmpty(sub.Sources)).
+		SetMutedSources(orEmpty(sub.MutedSources)).
+		SetKeywords(orEmpty(sub.Keywords)).
+		SetMutedAuthors(orEmpty(sub.MutedAuthors)).
+		SetMutedTags(orEmpty(sub.MutedTags)).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = c.db.Subscription.Create().
+			SetID(sub.ID).
+			SetSources(orEmpty(sub.Sources)).
+			SetMutedSources(orEmpty(sub.MutedSources)).
+			SetKeywords(orEmpty(sub.Keywords)).
+			SetMutedAuthors(orEmpty(sub.MutedAuthors)).
+			SetMutedTags(orEmpty(sub.MutedTags)).
+			Exec(ctx)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to save subscription of %s", sub.ID)
+	}
+
+	return nil
+}
+
+// saved lists the latest posts saved in a chat.
+func (c *Client) saved(ctx context.Context, chatId string) (string, error) {
+	saved, err := c.db.SavedPost.Query().
+		Where(savedpost.ChatIDEQ(chatId)).
+		Order(ent.Desc(savedpost.FieldCreatedAt)).
+		Limit(savedListLength).
+		WithPost().
+		All(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to query saved posts")
+	}
+
+	if len(saved) == 0 {
+		return "本群还没有收藏，点击推送卡片上的「收藏」按钮添加", nil
+	}
+
+	return "本群收藏：\n" + strings.Join(lo.Map(saved, func(item *ent.SavedPost, i int) string {
+		return fmt.Sprintf("%d. %s %s", i+1, item.Edges.Post.Title, item.Edges.Post.URL)
+	}), "\n"), nil
+}
+
+// unknownSources returns a reply listing the sources that have no posts.
+func (c *Client) unknownSources(ctx context.Context, sources []string) (string, error) {
+	known, err := c.db.Post.Query().
+		GroupBy(post.FieldSource).
+		Strings(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to query sources")
+	}
+
+	unknown := lo.Without(sources, known...)
+	if len(unknown) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("未知来源 %s，可用来源：%s", strings.Join(unknown, "、"), strings.Join(known, "、")), nil
+}
+
+func status(sub *ent.Subscription) string {
+	list := func(items []string, empty string) string {
+		if len(items) == 0 {
+			return empty
+		}
+		return strings.Join(items, "、")
+	}
+
+	return fmt.Sprintf("订阅来源：%s\n屏蔽来源：%s\n关键词：%s\n屏蔽作者：%s\n屏蔽标签：%s",
+		list(sub.Sources, "全部"),
+		list(sub.MutedSources, "无"),
+		list(sub.Keywords, "不限"),
+		list(sub.MutedAuthors, "无"),
+		list(sub.MutedTags, "无"))
+}
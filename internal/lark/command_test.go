@@ -0,0 +1,39 @@
+package lark
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/wintbiit/rmtv/ent"
+)
+
+func TestUnsubscribe(t *testing.T) {
+	video := testPost{source: "bilibili", title: "RMUC 工程机器人兑换"}
+	answer := testPost{source: "qflow", title: "发射机构初速上限"}
+
+	sub := &ent.Subscription{Sources: []string{"bilibili", "qflow"}}
+	if _, ok := unsubscribe(sub, []string{"qflow"}); !ok {
+		t.Fatal("unsubscribing one of two sources is refused")
+	}
+	if !slices.Equal(sub.Sources, []string{"bilibili"}) || accepts(sub, answer) {
+		t.Fatalf("unexpected subscription: %+v", sub)
+	}
+
+	// Unsubscribing the only subscribed source would leave the chat
+	// receiving every source.
+	if _, ok := unsubscribe(sub, []string{"bilibili"}); ok {
+		t.Fatal("unsubscribing the only source is accepted")
+	}
+	if !slices.Equal(sub.Sources, []string{"bilibili"}) || !accepts(sub, video) || accepts(sub, answer) {
+		t.Fatalf("refused unsubscribe changed the subscription: %+v", sub)
+	}
+
+	// Without subscribed sources, unsubscribing mutes the source.
+	all := &ent.Subscription{}
+	if _, ok := unsubscribe(all, []string{"qflow"}); !ok {
+		t.Fatal("unsubscribing from all sources is refused")
+	}
+	if !accepts(all, video) || accepts(all, answer) {
+		t.Fatalf("unexpected subscription: %+v", all)
+	}
+}
@@ -0,0 +1,65 @@
+package lark
+
+import (
+	"context"
+	"encoding/json"
+
+	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// HandleEvents registers the handlers of the events the client reacts to,
+// answering the bot commands members send in chats, welcoming new chats and
+// applying the actions of cards.
+func (c *Client) HandleEvents(h *EventHandler) {
+	On(h, "im.message.receive_v1", c.onMessage)
+	On(h, "im.chat.member.bot.added_v1", c.onBotAdded)
+	On(h, "card.action.trigger", c.onCardAction)
+}
+
+func (c *Client) onMessage(ctx context.Context, header *EventHeader, event *larkim.P2MessageReceiveV1Data) (any, error) {
+	message := event.Message
+	if message == nil || lo.FromPtr(message.MessageType) != larkim.MsgTypeText {
+		return nil, nil
+	}
+
+	var content struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal([]byte(lo.FromPtr(message.Content)), &content); err != nil {
+		return nil, errors.Wrap(err, "failed to decode message content")
+	}
+
+	reply, err := c.command(ctx, lo.FromPtr(message.ChatId), content.Text)
+	if err != nil {
+		logrus.Errorf("failed to run command %q: %v", content.Text, err)
+		reply = "命令执行失败，请稍后重试"
+	}
+	if reply == "" {
+		return nil, nil
+	}
+
+	return nil, c.reply(ctx, lo.FromPtr(message.MessageId), reply)
+}
+
+func (c *Client) reply(ctx context.Context, messageId, text string) error {
+	content, _ := json.Marshal(map[string]string{"text": text})
+	resp, err := c.client.Im.V1.Message.Reply(ctx, larkim.NewReplyMessageReqBuilder().
+		MessageId(messageId).
+		Body(larkim.NewReplyMessageReqBodyBuilder().
+			MsgType(larkim.MsgTypeText).
+			Content(string(content)).
+			Build()).
+		Build())
+	if err != nil {
+		return errors.Wrap(err, "failed to reply message")
+	}
+
+	if !resp.Success() {
+		return errors.Wrap(resp, "failed to reply message")
+	}
+
+	return nil
+}
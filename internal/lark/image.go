@@ -1,13 +1,271 @@
 package lark
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
+	"net/http"
+	"sync"
+	"time"
 
 	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/imagecache"
+	"github.com/wintbiit/rmtv/internal/job"
 )
 
+const (
+	// maxImageSize is the largest image Lark accepts for messages.
+	maxImageSize         = 10 << 20
+	imageDownloadTimeout = 15 * time.Second
+	// imageUploadLimit bounds the images downloaded and uploaded at once.
+	imageUploadLimit = 4
+	// DefaultImageCacheTTL is how long uploaded image keys are reused.
+	DefaultImageCacheTTL = 7 * 24 * time.Hour
+)
+
+// imageTypes are the sniffed content types Lark accepts for message images.
+var imageTypes = []string{"image/jpeg", "image/png", "image/webp", "image/gif", "image/bmp", "image/x-icon"}
+
+var imageHttpClient = &http.Client{Timeout: imageDownloadTimeout}
+
+// SetImageCacheTTL sets how long uploaded image keys are reused for the same
+// picture url or content. Keys are cached only when the database is set.
+func (c *Client) SetImageCacheTTL(ttl time.Duration) {
+	c.imageCacheTTL = ttl
+}
+
+// uploadImages uploads the picture of every post, with an empty key for posts
+// without one or whose upload failed. Posts sharing a picture share a key. A
+// nil client uploads nothing.
+func (c *Client) uploadImages(ctx context.Context, messages []job.Post) []string {
+	keys := make([]string, len(messages))
+	if c == nil {
+		return keys
+	}
+
+	urls := lo.Uniq(lo.FilterMap(messages, func(item job.Post, _ int) (string, bool) {
+		url := lo.FromPtr(item.GetPic())
+		return url, url != ""
+	}))
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		uploaded = make(map[string]string, len(urls))
+		limit    = make(chan struct{}, imageUploadLimit)
+	)
+	for _, url := range urls {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-limit }()
+
+			key, err := c.imageKey(ctx, url)
+			if err != nil {
+				logrus.Errorf("Failed to upload image %s: %v", url, err)
+				return
+			}
+
+			mu.Lock()
+			uploaded[url] = key
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	for i, item := range messages {
+		keys[i] = uploaded[lo.FromPtr(item.GetPic())]
+	}
+
+	return keys
+}
+
+// imageKey returns the key of the image at url, reusing the key cached for
+// the url or, once downloaded, for the same content.
+func (c *Client) imageKey(ctx context.Context, url string) (string, error) {
+	if key := c.cachedImageKey(ctx, url, ""); key != "" {
+		return key, nil
+	}
+
+	image, err := downloadImage(ctx, url)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(image)
+	hash := hex.EncodeToString(sum[:])
+	key := c.cachedImageKey(ctx, "", hash)
+	if key == "" {
+		key, err = c.uploadImage(ctx, bytes.NewReader(image))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := c.cacheImageKey(ctx, url, hash, key); err != nil {
+		logrus.Warnf("Failed to cache image key of %s: %v", url, err)
+	}
+
+	return key, nil
+}
+
+// cachedImageKey returns the newest unexpired key of the url or, with an
+// empty url, of the content hash. It is empty when there is none or the
+// cache is unavailable.
+func (c *Client) cachedImageKey(ctx context.Context, url, hash string) string {
+	if c.images == nil {
+		return ""
+	}
+
+	key, err := c.images.get(ctx, c.appId, url, hash, time.Now().Add(-c.imageCacheTTL))
+	if err != nil {
+		logrus.Warnf("Failed to query image cache: %v", err)
+		return ""
+	}
+
+	return key
+}
+
+// cacheImageKey records the key of the image at url and drops expired keys.
+func (c *Client) cacheImageKey(ctx context.Context, url, hash, key string) error {
+	if c.images == nil {
+		return nil
+	}
+
+	now := time.Now()
+	return c.images.put(ctx, cachedImage{
+		AppId:       c.appId,
+		Url:         url,
+		ContentHash: hash,
+		ImageKey:    key,
+		CreatedAt:   now,
+	}, now.Add(-c.imageCacheTTL))
+}
+
+type cachedImage struct {
+	AppId       string
+	Url         string
+	ContentHash string
+	ImageKey    string
+	CreatedAt   time.Time
+}
+
+// imageCache keeps the keys of the images uploaded by each Lark app.
+type imageCache interface {
+	// get returns the newest key of appId created after since for url or,
+	// with an empty url, for the content hash, empty when there is none.
+	get(ctx context.Context, appId, url, hash string, since time.Time) (string, error)
+	// put records the key of an image by its url, replacing the previous
+	// one, and drops the keys created before expired.
+	put(ctx context.Context, image cachedImage, expired time.Time) error
+}
+
+// dbImageCache keeps image keys in the image_caches table.
+type dbImageCache struct {
+	db *ent.Client
+}
+
+func imageCacheId(appId, url string) string {
+	sum := sha256.Sum256([]byte(appId + "\n" + url))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c dbImageCache) get(ctx context.Context, appId, url, hash string, since time.Time) (string, error) {
+	match := imagecache.And(imagecache.AppID(appId), imagecache.ContentHash(hash))
+	if url != "" {
+		match = imagecache.ID(imageCacheId(appId, url))
+	}
+
+	cached, err := c.db.ImageCache.Query().
+		Where(match, imagecache.CreatedAtGT(since)).
+		Order(ent.Desc(imagecache.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return cached.ImageKey, nil
+}
+
+func (c dbImageCache) put(ctx context.Context, image cachedImage, expired time.Time) error {
+	id := imageCacheId(image.AppId, image.Url)
+	err := c.db.ImageCache.UpdateOneID(id).
+		SetContentHash(image.ContentHash).
+		SetImageKey(image.ImageKey).
+		SetCreatedAt(image.CreatedAt).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = c.db.ImageCache.Create().
+			SetID(id).
+			SetAppID(image.AppId).
+			SetURL(image.Url).
+			SetContentHash(image.ContentHash).
+			SetImageKey(image.ImageKey).
+			SetCreatedAt(image.CreatedAt).
+			Exec(ctx)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to save image key")
+	}
+
+	if _, err := c.db.ImageCache.Delete().
+		Where(imagecache.CreatedAtLT(expired)).
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to delete expired image keys")
+	}
+
+	return nil
+}
+
+// downloadImage fetches an image Lark accepts, rejecting other types of
+// content and images over maxImageSize.
+func downloadImage(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid image url")
+	}
+
+	resp, err := imageHttpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get image")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to get image: %s", resp.Status)
+	}
+
+	if resp.ContentLength > maxImageSize {
+		return nil, errors.Errorf("image of %d bytes is too large", resp.ContentLength)
+	}
+
+	image, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read image")
+	}
+	if len(image) > maxImageSize {
+		return nil, errors.Errorf("image over %d bytes is too large", maxImageSize)
+	}
+
+	// CDNs often serve images as application/octet-stream, the content
+	// tells the type.
+	if mediaType := http.DetectContentType(image); !lo.Contains(imageTypes, mediaType) {
+		return nil, errors.Errorf("unsupported image type %q", mediaType)
+	}
+
+	return image, nil
+}
+
 func (c *Client) uploadImage(ctx context.Context, image io.Reader) (string, error) {
 	req := larkim.NewCreateImageReqBuilder().
 		Body(larkim.NewCreateImageReqBodyBuilder().
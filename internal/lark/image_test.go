@@ -0,0 +1,208 @@
+package lark
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lark "github.com/larksuite/oapi-sdk-go/v3"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+func TestDownloadImage(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cover.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(png)
+		case "/cdn/cover":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Write(png)
+		case "/fake.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte("<html></html>"))
+		case "/page.html":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte("<html></html>"))
+		case "/large.jpg":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Header().Set("Content-Length", "20971520")
+		case "/chunked.jpg":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.(http.Flusher).Flush()
+			w.Write(bytes.Repeat([]byte{0}, maxImageSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// The type is sniffed from the content, whatever the server claims.
+	for _, path := range []string{"/cover.png", "/cdn/cover"} {
+		image, err := downloadImage(context.Background(), server.URL+path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(image, png) {
+			t.Errorf("%s: image = %q, want %q", path, image, png)
+		}
+	}
+
+	for path, want := range map[string]string{
+		"/page.html":   "unsupported image type",
+		"/fake.png":    "unsupported image type",
+		"/large.jpg":   "too large",
+		"/chunked.jpg": "too large",
+		"/missing.png": "404",
+	} {
+		_, err := downloadImage(context.Background(), server.URL+path)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: err = %v, want %q", path, err, want)
+		}
+	}
+}
+
+// memoryImageCache keeps image keys in memory, in the order they were put.
+type memoryImageCache struct {
+	mu     sync.Mutex
+	images []cachedImage
+}
+
+func (c *memoryImageCache) get(ctx context.Context, appId, url, hash string, since time.Time) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := len(c.images) - 1; i >= 0; i-- {
+		image := c.images[i]
+		matched := image.ContentHash == hash
+		if url != "" {
+			matched = image.Url == url
+		}
+		if image.AppId == appId && matched && image.CreatedAt.After(since) {
+			return image.ImageKey, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (c *memoryImageCache) put(ctx context.Context, image cachedImage, expired time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	kept := c.images[:0]
+	for _, item := range c.images {
+		if !(item.AppId == image.AppId && item.Url == image.Url) && !item.CreatedAt.Before(expired) {
+			kept = append(kept, item)
+		}
+	}
+	c.images = append(kept, image)
+
+	return nil
+}
+
+// newTestImageClient returns a client of app uploading to a fake Lark server,
+// which answers every upload with a new key.
+func newTestImageClient(t *testing.T, app string, cache imageCache, uploads *atomic.Int32) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "_access_token/internal"):
+			json.NewEncoder(w).Encode(map[string]any{
+				"code":                0,
+				"app_access_token":    "a-token",
+				"tenant_access_token": "t-token",
+				"expire":              7200,
+			})
+		case r.URL.Path == "/open-apis/im/v1/images":
+			n := uploads.Add(1)
+			json.NewEncoder(w).Encode(map[string]any{
+				"code": 0,
+				"data": map[string]string{"image_key": app + "_img_" + strconv.Itoa(int(n))},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return &Client{
+		client:        lark.NewClient(app, "secret", lark.WithOpenBaseUrl(server.URL)),
+		appId:         app,
+		images:        cache,
+		imageCacheTTL: time.Hour,
+	}
+}
+
+func TestUploadImagesCache(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n")
+	var downloads atomic.Int32
+	pictures := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		w.Write(png)
+	}))
+	defer pictures.Close()
+
+	post := func(path string) job.Post {
+		url := pictures.URL + path
+		return testPost{source: "bilibili", title: path, pic: &url}
+	}
+
+	var uploads atomic.Int32
+	cache := &memoryImageCache{}
+	client := newTestImageClient(t, "cli_a", cache, &uploads)
+	ctx := context.Background()
+
+	// Posts sharing a picture share its upload.
+	keys := client.uploadImages(ctx, []job.Post{post("/a.png"), post("/a.png")})
+	if keys[0] == "" || keys[0] != keys[1] || downloads.Load() != 1 || uploads.Load() != 1 {
+		t.Fatalf("keys %v after %d downloads and %d uploads", keys, downloads.Load(), uploads.Load())
+	}
+
+	// Another url of the same content is downloaded but not uploaded again.
+	if same := client.uploadImages(ctx, []job.Post{post("/b.png")}); same[0] != keys[0] || downloads.Load() != 2 || uploads.Load() != 1 {
+		t.Fatalf("key %s after %d downloads and %d uploads", same[0], downloads.Load(), uploads.Load())
+	}
+
+	// Cached urls are neither downloaded nor uploaded again.
+	if again := client.uploadImages(ctx, []job.Post{post("/b.png")}); again[0] != keys[0] || downloads.Load() != 2 || uploads.Load() != 1 {
+		t.Fatalf("key %s after %d downloads and %d uploads", again[0], downloads.Load(), uploads.Load())
+	}
+
+	// Keys of another app cannot be used by this one.
+	var otherUploads atomic.Int32
+	other := newTestImageClient(t, "cli_b", cache, &otherUploads)
+	if otherKeys := other.uploadImages(ctx, []job.Post{post("/a.png")}); otherKeys[0] == keys[0] || otherUploads.Load() != 1 {
+		t.Fatalf("other app got key %s after %d uploads", otherKeys[0], otherUploads.Load())
+	}
+
+	// Expired keys are uploaded again.
+	cache.mu.Lock()
+	for i := range cache.images {
+		cache.images[i].CreatedAt = time.Now().Add(-2 * time.Hour)
+	}
+	cache.mu.Unlock()
+	if expired := client.uploadImages(ctx, []job.Post{post("/a.png")}); expired[0] == keys[0] || uploads.Load() != 2 {
+		t.Fatalf("key %s after %d uploads, want a new upload", expired[0], uploads.Load())
+	}
+	if len(cache.images) != 1 {
+		t.Fatalf("%d cached keys, want the expired ones dropped", len(cache.images))
+	}
+}
+
+func TestUploadImagesWithoutClient(t *testing.T) {
+	var c *Client
+	url := "https://i0.hdslb.com/bfs/archive/cover.jpg"
+	if keys := c.uploadImages(context.Background(), []job.Post{testPost{title: "a", pic: &url}}); keys[0] != "" {
+		t.Fatalf("nil client uploaded %v", keys)
+	}
+}
@@ -2,12 +2,16 @@ package lark
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
 
 	lark "github.com/larksuite/oapi-sdk-go/v3"
 	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/ent"
 	"github.com/wintbiit/rmtv/internal/job"
 )
 
@@ -19,78 +23,102 @@ type Config struct {
 
 type Client struct {
 	client          *lark.Client
+	appId           string
 	webhookProvider WebhookProvider
+	db              *ent.Client
+	routes          []job.Route
+	destinations    map[string][]string
+	digestCount     int
+	template        *Template
+	images          imageCache
+	imageCacheTTL   time.Duration
+}
+
+const Module = "lark"
+
+func (c *Client) Name() string {
+	return Module
 }
 
 func NewClient(appId, appSecret string) *Client {
 	larkClient := lark.NewClient(appId, appSecret)
 
 	client := &Client{
-		client: larkClient,
-	}
-
-	if imageUploadClient == nil {
-		imageUploadClient = client
+		client:        larkClient,
+		appId:         appId,
+		imageCacheTTL: DefaultImageCacheTTL,
 	}
 
 	return client
 }
 
+// SetTemplate pushes cards filled from hosted templates instead of code built
+// cards, which also drops the card actions.
+func (c *Client) SetTemplate(template *Template) {
+	c.template = template
+}
+
 func (c *Client) PushMessageToChat(ctx context.Context, chatId string, content string) error {
+	_, err := c.createMessage(ctx, chatId, content)
+	return err
+}
+
+// createMessage pushes a card to a chat and returns the id of its message.
+func (c *Client) createMessage(ctx context.Context, chatId string, content string) (string, error) {
 	req := larkim.NewCreateMessageReqBuilder().
 		ReceiveIdType(larkim.ReceiveIdTypeChatId).
 		Body(larkim.NewCreateMessageReqBodyBuilder().
 			ReceiveId(chatId).
 			MsgType(larkim.MsgTypeInteractive).
 			Content(content).
+			Uuid(messageUuid(chatId, content)).
 			Build()).
 		Build()
 
 	resp, err := c.client.Im.V1.Message.Create(ctx, req)
 	if err != nil {
-		return errors.Wrap(err, "failed to create message")
+		return "", errors.Wrap(err, "failed to create message")
 	}
 
 	if !resp.Success() {
-		return errors.Wrap(resp, "failed to create message")
+		return "", errors.Wrap(resp, "failed to create message")
 	}
 
 	logrus.Infof("successfully pushed message to chat: %s", chatId)
-	return nil
+	return lo.FromPtr(resp.Data.MessageId), nil
+}
+
+// messageUuid identifies a message for Lark to drop the same content pushed
+// to the same chat again within an hour, as when a delivery is retried.
+func messageUuid(chatId, content string) string {
+	sum := sha256.Sum256([]byte(chatId + "\n" + content))
+	return hex.EncodeToString(sum[:16])
 }
 
 func (c *Client) PushMessage(ctx context.Context, videos []job.Post) error {
-	message, err := BuildMessageCard(ctx, videos)
-	if err != nil {
-		return err
+	chats := make([]string, 0)
+	if err := c.ForeachChat(ctx, func(chat *larkim.ListChat) {
+		chats = append(chats, *chat.ChatId)
+	}); err != nil {
+		return errors.Wrap(err, "failed to push message to chat")
 	}
 
-	messageData, _ := json.Marshal(message)
-	if err = c.ForeachChat(ctx, func(chat *larkim.ListChat) {
-		req := larkim.NewCreateMessageReqBuilder().
-			ReceiveIdType(larkim.ReceiveIdTypeChatId).
-			Body(larkim.NewCreateMessageReqBodyBuilder().
-				ReceiveId(*chat.ChatId).
-				MsgType(larkim.MsgTypeInteractive).
-				Content(string(messageData)).
-				Build()).
-			Build()
-
-		resp, err := c.client.Im.V1.Message.Create(ctx, req)
-		if err != nil {
-			logrus.Errorf("failed to create message: %v", err)
-			return
-		}
-
-		if !resp.Success() {
-			logrus.Error(errors.Wrap(resp, "failed to create message"))
-			return
-		}
-
-		logrus.Infof("successfully pushed message to chat: %s(%s)", *chat.Name, *chat.ChatId)
+	return c.pushToChats(ctx, chats, videos)
+}
+
+// Targets returns every chat the bot is in.
+func (c *Client) Targets(ctx context.Context) ([]string, error) {
+	chats := make([]string, 0)
+	if err := c.ForeachChat(ctx, func(chat *larkim.ListChat) {
+		chats = append(chats, *chat.ChatId)
 	}); err != nil {
-		logrus.Errorf("failed to push message to chat: %v", err)
+		return nil, err
 	}
 
-	return nil
+	return chats, nil
+}
+
+// PushMessageTo pushes to chat the posts its subscription accepts.
+func (c *Client) PushMessageTo(ctx context.Context, chat string, videos []job.Post) error {
+	return c.pushToChats(ctx, []string{chat}, videos)
 }
@@ -0,0 +1,208 @@
+package lark
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+const cardDescLength = 120
+
+// cardAction is the value of the action buttons on a post of a card. It
+// names only that post, the posts of the card are recorded when pushed.
+type cardAction struct {
+	Action string `json:"action"`
+	Post   string `json:"post"`
+	Author string `json:"author,omitempty"`
+	Source string `json:"source,omitempty"`
+}
+
+const (
+	actionSave         = "save"
+	actionUnsave       = "unsave"
+	actionMuteAuthor   = "mute_author"
+	actionUnmuteAuthor = "unmute_author"
+	actionMuteSource   = "mute_source"
+	actionUnmuteSource = "unmute_source"
+	// Tag actions are overflow options, their value is the action, a colon
+	// and the tag.
+	actionMuteTag   = "mute_tag"
+	actionUnmuteTag = "unmute_tag"
+)
+
+// cardState is what a chat did to the posts of a card.
+type cardState struct {
+	sub   *ent.Subscription
+	saved map[string]bool
+}
+
+func (s cardState) mutedAuthor(author string) bool {
+	return s.sub != nil && lo.Contains(s.sub.MutedAuthors, author)
+}
+
+func (s cardState) mutedSource(source string) bool {
+	return s.sub != nil && lo.Contains(s.sub.MutedSources, source)
+}
+
+func (s cardState) mutedTag(tag string) bool {
+	return s.sub != nil && lo.ContainsBy(s.sub.MutedTags, func(item string) bool {
+		return strings.EqualFold(item, tag)
+	})
+}
+
+// BuildCard builds a card listing the posts. With actions, every post has
+// buttons to save it to the chat's list or mute its author, source or tags,
+// and the card can be rebuilt in place after an action. Pictures are
+// uploaded by uploader, posts whose picture could not be uploaded are shown
+// without one and a nil uploader shows none.
+func BuildCard(ctx context.Context, uploader *Client, messages []job.Post, actions bool) *Card {
+	return buildCard(messages, uploader.uploadImages(ctx, messages), actions, cardState{})
+}
+
+func buildCard(messages []job.Post, images []string, actions bool, state cardState) *Card {
+	elements := make([]CardElement, 0, len(messages)*3+2)
+	for i, item := range messages {
+		if i > 0 {
+			elements = append(elements, Hr{})
+		}
+		elements = append(elements, postPanel(item, images[i]))
+		if actions {
+			elements = append(elements, ColumnSet{
+				HorizontalSpacing: "small",
+				Columns: lo.Map(postActions(item, state), func(action CardElement, _ int) Column {
+					return Column{Width: "auto", Elements: []CardElement{action}}
+				}),
+			})
+		}
+	}
+	elements = append(elements, Hr{}, Markdown{
+		Content:  "来自 [rmtv](https://github.com/wintbiit/rmtv)",
+		TextSize: "notation",
+	})
+
+	return &Card{
+		Schema: "2.0",
+		Config: CardConfig{
+			UpdateMulti: true,
+			WidthMode:   "fill",
+		},
+		Header: &CardHeader{
+			Title:    PlainText("RoboMaster TV"),
+			Subtitle: lo.ToPtr(PlainText(fmt.Sprintf("%d 条新内容", len(messages)))),
+			Template: "blue",
+		},
+		Body: CardBody{Elements: elements},
+	}
+}
+
+// postPanel shows a post with its picture, if any, on the left.
+func postPanel(item job.Post, image string) ColumnSet {
+	content := Column{
+		Width:    "weighted",
+		Weight:   1,
+		Elements: []CardElement{Markdown{Content: postMarkdown(item)}},
+	}
+	if image == "" {
+		return ColumnSet{Columns: []Column{content}}
+	}
+
+	content.Weight = 3
+	return ColumnSet{
+		HorizontalSpacing: "medium",
+		Columns: []Column{
+			{
+				Width:         "weighted",
+				Weight:        1,
+				VerticalAlign: "top",
+				Elements: []CardElement{Image{
+					ImgKey:    image,
+					Alt:       PlainText(item.GetTitle()),
+					ScaleType: "crop_center",
+					Size:      "stretch",
+				}},
+			},
+			content,
+		},
+	}
+}
+
+func postMarkdown(item job.Post) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<text_tag color='%s'>%s</text_tag> **[%s](%s)**\n", item.GetTypeColor(), item.GetType(), item.GetTitle(), item.GetUrl())
+	for _, tag := range item.GetTags() {
+		fmt.Fprintf(&b, "<text_tag color='blue'>%s</text_tag> ", tag)
+	}
+	if len(item.GetTags()) > 0 {
+		b.WriteString("\n")
+	}
+	switch {
+	case item.GetAuthor() != "" && item.GetAuthorUrl() != "":
+		fmt.Fprintf(&b, "[%s](%s) · ", item.GetAuthor(), item.GetAuthorUrl())
+	case item.GetAuthor() != "":
+		b.WriteString(item.GetAuthor() + " · ")
+	}
+	b.WriteString(item.GetPubDate().Local().Format(time.DateTime))
+	if desc := strings.TrimSpace(item.GetDesc()); desc != "" {
+		if runes := []rune(desc); len(runes) > cardDescLength {
+			desc = string(runes[:cardDescLength]) + "…"
+		}
+		b.WriteString("\n" + desc)
+	}
+	if extra := item.GetExtra(); extra != nil && extra.String() != "" {
+		b.WriteString("\n" + extra.String())
+	}
+
+	return b.String()
+}
+
+func postActions(item job.Post, state cardState) []CardElement {
+	var source string
+	if sourced, ok := item.(job.SourcedPost); ok {
+		source = sourced.GetSource()
+	}
+	value := func(action string) cardAction {
+		return cardAction{Action: action, Post: item.GetId(), Author: item.GetAuthor(), Source: source}
+	}
+	button := func(text, kind string, value cardAction) Button {
+		return Button{
+			Text:      PlainText(text),
+			Type:      kind,
+			Size:      "small",
+			Behaviors: []Behavior{Callback(value)},
+		}
+	}
+
+	actions := []CardElement{
+		lo.Ternary(state.saved[item.GetId()],
+			button("已收藏，取消", "default", value(actionUnsave)),
+			button("收藏", "primary", value(actionSave))),
+	}
+	if item.GetAuthor() != "" {
+		actions = append(actions, lo.Ternary(state.mutedAuthor(item.GetAuthor()),
+			button("取消屏蔽作者", "default", value(actionUnmuteAuthor)),
+			button("屏蔽作者", "danger", value(actionMuteAuthor))))
+	}
+	if source != "" {
+		actions = append(actions, lo.Ternary(state.mutedSource(source),
+			button("取消屏蔽来源", "default", value(actionUnmuteSource)),
+			button("屏蔽来源", "danger", value(actionMuteSource))))
+	}
+	if tags := item.GetTags(); len(tags) > 0 {
+		actions = append(actions, Overflow{
+			Options: lo.Map(tags, func(tag string, _ int) OverflowOption {
+				if state.mutedTag(tag) {
+					return OverflowOption{Text: PlainText("取消屏蔽标签 " + tag), Value: actionUnmuteTag + ":" + tag}
+				}
+				return OverflowOption{Text: PlainText("屏蔽标签 " + tag), Value: actionMuteTag + ":" + tag}
+			}),
+			Value: value(""),
+		})
+	}
+
+	return actions
+}
@@ -0,0 +1,98 @@
+package lark
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// golden compares v encoded as indented JSON with testdata/name.
+func golden(t *testing.T, name string, v any) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(expected) {
+		t.Errorf("%s does not match, run go test -update:\n%s", path, data)
+	}
+}
+
+func cardPosts(t *testing.T) []job.Post {
+	local := time.Local
+	time.Local = time.FixedZone("CST", 8*60*60)
+	t.Cleanup(func() {
+		time.Local = local
+	})
+
+	return []job.Post{
+		testPost{
+			source: "bilibili",
+			title:  "RMUC 自瞄开源",
+			tags:   []string{"视觉", "RoboMaster"},
+			author: "上海交通大学",
+			url:    "https://www.bilibili.com/video/BV1xx411c7mD",
+			desc:   "基于 YOLOv8 的装甲板识别与弹道解算，附完整代码与标定流程。",
+			date:   time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC),
+		},
+		testPost{
+			source: "qflow",
+			title:  "发射机构初速上限",
+			url:    "https://qingflow.com/appView/a/shareView/b?applyId=1",
+			desc:   "以裁判系统测速模块为准。",
+			date:   time.Date(2025, 5, 2, 8, 30, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestBuildCard(t *testing.T) {
+	posts := cardPosts(t)
+
+	// Only the post whose picture was uploaded has an image.
+	golden(t, "card.json", buildCard(posts, []string{"img_1", ""}, false, cardState{}))
+}
+
+func TestBuildCardActions(t *testing.T) {
+	posts := cardPosts(t)
+
+	golden(t, "card_actions.json", buildCard(posts, []string{"img_1", ""}, true, cardState{}))
+
+	// Actions taken are reflected by the buttons of a rebuilt card.
+	golden(t, "card_actions_state.json", buildCard(posts, []string{"img_1", ""}, true, cardState{
+		sub:   &ent.Subscription{MutedAuthors: []string{"上海交通大学"}, MutedSources: []string{"qflow"}, MutedTags: []string{"视觉"}},
+		saved: map[string]bool{"发射机构初速上限": true},
+	}))
+}
+
+func TestBuildTemplateCard(t *testing.T) {
+	posts := cardPosts(t)
+
+	golden(t, "card_template.json", BuildTemplateCard(context.Background(), nil, DefaultTemplate, posts))
+}
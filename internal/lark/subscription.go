@@ -0,0 +1,106 @@
+package lark
+
+import (
+	"context"
+	"encoding/json"
+	errors2 "errors"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+func (c *Client) SetDb(db *ent.Client) {
+	c.db = db
+	c.images = dbImageCache{db: db}
+}
+
+// subscriptions returns the subscription of every chat that has set one.
+func (c *Client) subscriptions(ctx context.Context) (map[string]*ent.Subscription, error) {
+	if c.db == nil {
+		return nil, nil
+	}
+
+	subscriptions, err := c.db.Subscription.Query().All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query subscriptions")
+	}
+
+	return lo.SliceToMap(subscriptions, func(item *ent.Subscription) (string, *ent.Subscription) {
+		return item.ID, item
+	}), nil
+}
+
+// accepts reports whether a chat subscribed with sub receives item. Chats
+// without a subscription receive everything.
+func accepts(sub *ent.Subscription, item job.Post) bool {
+	if sub == nil {
+		return true
+	}
+
+	if sp, ok := item.(job.SourcedPost); ok {
+		source := sp.GetSource()
+		if len(sub.Sources) > 0 && !lo.Contains(sub.Sources, source) || lo.Contains(sub.MutedSources, source) {
+			return false
+		}
+	}
+
+	if lo.Contains(sub.MutedAuthors, item.GetAuthor()) || lo.SomeBy(item.GetTags(), func(tag string) bool {
+		return lo.ContainsBy(sub.MutedTags, func(muted string) bool {
+			return strings.EqualFold(tag, muted)
+		})
+	}) {
+		return false
+	}
+
+	if len(sub.Keywords) == 0 {
+		return true
+	}
+
+	text := strings.ToLower(strings.Join(append([]string{item.GetTitle(), item.GetDesc()}, item.GetTags()...), "\n"))
+	return lo.SomeBy(sub.Keywords, func(keyword string) bool {
+		return strings.Contains(text, strings.ToLower(keyword))
+	})
+}
+
+// pushToChats pushes to every chat the posts its subscription accepts. Chats
+// receiving the same posts share a single card. It fails unless every chat
+// was pushed, chats already pushed are deduped by Lark when retried.
+func (c *Client) pushToChats(ctx context.Context, chats []string, videos []job.Post) error {
+	subscriptions, err := c.subscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	cards := make(map[string]string)
+	var errs []error
+	for _, chat := range chats {
+		posts := lo.Filter(videos, func(item job.Post, _ int) bool {
+			return accepts(subscriptions[chat], item)
+		})
+		if len(posts) == 0 {
+			logrus.Debugf("no subscribed posts for chat %s", chat)
+			continue
+		}
+
+		key := strings.Join(lo.Map(posts, func(item job.Post, _ int) string {
+			return item.GetId()
+		}), ",")
+		content, ok := cards[key]
+		if !ok {
+			data, _ := json.Marshal(buildMessageCard(ctx, c, c.template, posts, true))
+			content = string(data)
+			cards[key] = content
+		}
+
+		if err := c.pushCard(ctx, chat, content, posts); err != nil {
+			logrus.Errorf("failed to push to chat %s: %v", chat, err)
+			errs = append(errs, errors.Wrapf(err, "chat %s", chat))
+		}
+	}
+
+	return errors2.Join(errs...)
+}
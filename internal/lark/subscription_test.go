@@ -0,0 +1,89 @@
+package lark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+type testPost struct {
+	source string
+	title  string
+	tags   []string
+	author string
+	url    string
+	desc   string
+	pic    *string
+	date   time.Time
+}
+
+func (p testPost) GetSource() string       { return p.source }
+func (p testPost) GetType() string         { return p.source }
+func (p testPost) GetTypeColor() string    { return "blue" }
+func (p testPost) GetId() string           { return p.title }
+func (p testPost) GetPic() *string         { return p.pic }
+func (p testPost) GetTitle() string        { return p.title }
+func (p testPost) GetDesc() string         { return p.desc }
+func (p testPost) GetTags() []string       { return p.tags }
+func (p testPost) GetPubDate() time.Time   { return p.date }
+func (p testPost) GetAuthor() string       { return p.author }
+func (p testPost) GetAuthorUrl() string    { return "" }
+func (p testPost) GetUrl() string          { return p.url }
+func (p testPost) GetExtra() job.PostExtra { return nil }
+
+func TestAccepts(t *testing.T) {
+	video := testPost{source: "bilibili", title: "RMUC 工程机器人兑换", tags: []string{"RoboMaster"}}
+	answer := testPost{source: "qflow", title: "发射机构初速上限", tags: []string{"视觉"}, author: "华南理工大学"}
+
+	for _, c := range []struct {
+		name string
+		sub  *ent.Subscription
+		want [2]bool
+	}{
+		{"no subscription", nil, [2]bool{true, true}},
+		{"sources", &ent.Subscription{Sources: []string{"bilibili"}}, [2]bool{true, false}},
+		{"muted", &ent.Subscription{MutedSources: []string{"qflow"}}, [2]bool{true, false}},
+		{"subscribed and muted", &ent.Subscription{Sources: []string{"qflow"}, MutedSources: []string{"qflow"}}, [2]bool{false, false}},
+		{"keywords", &ent.Subscription{Keywords: []string{"工程"}}, [2]bool{true, false}},
+		{"keyword in tags", &ent.Subscription{Keywords: []string{"视觉", "rmul"}}, [2]bool{false, true}},
+		{"keyword case", &ent.Subscription{Keywords: []string{"rmuc"}}, [2]bool{true, false}},
+		{"muted author", &ent.Subscription{MutedAuthors: []string{"华南理工大学"}}, [2]bool{true, false}},
+		{"muted tag", &ent.Subscription{MutedTags: []string{"robomaster"}}, [2]bool{false, true}},
+	} {
+		if got := [2]bool{accepts(c.sub, video), accepts(c.sub, answer)}; got != c.want {
+			t.Errorf("%s: accepts %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRouted(t *testing.T) {
+	c := &Client{}
+	video := testPost{source: "bilibili", title: "RMUC 自瞄开源", tags: []string{"视觉"}}
+	answer := testPost{source: "qflow", title: "发射机构初速上限"}
+
+	if !c.routed("oc_any", video) || !c.routed("oc_any", answer) {
+		t.Fatal("posts are not routed to every chat without routes")
+	}
+
+	c.SetRoutes([]job.Route{
+		{Sources: []string{"bilibili"}, Tags: []string{"视觉"}, Consumers: []string{"vision"}},
+		{Sources: []string{"qflow"}, Consumers: []string{Module}},
+	}, map[string][]string{"vision": {"oc_vision"}})
+
+	for _, r := range []struct {
+		chat string
+		item testPost
+		want bool
+	}{
+		{"oc_vision", video, true},
+		{"oc_other", video, false},
+		{"oc_vision", answer, true},
+		{"oc_other", answer, true},
+	} {
+		if got := c.routed(r.chat, r.item); got != r.want {
+			t.Errorf("%s routed to %s: %v, want %v", r.item.title, r.chat, got, r.want)
+		}
+	}
+}
@@ -2,10 +2,14 @@ package lark
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	errors2 "errors"
 	"time"
 
 	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/wintbiit/rmtv/internal/job"
 	"github.com/wintbiit/rmtv/utils"
@@ -17,11 +21,22 @@ type WebhookProvider interface {
 }
 
 type WebhookClient struct {
+	name     string
 	client   *resty.Client
 	webhooks []string
+	template *Template
+	uploader *Client
 }
 
-func NewWebhookClient(webhooks []string) *WebhookClient {
+const WebhookModule = "lark-webhook"
+
+func (c *WebhookClient) Name() string {
+	return c.name
+}
+
+// NewWebhookClient returns a consumer named name pushing to webhooks, which
+// is WebhookModule unless it serves a routing destination.
+func NewWebhookClient(name string, webhooks []string) *WebhookClient {
 	c := resty.New().
 		SetRetryCount(3).
 		SetRetryWaitTime(2 * time.Second).
@@ -30,6 +45,7 @@ func NewWebhookClient(webhooks []string) *WebhookClient {
 		SetTimeout(10 * time.Second)
 
 	client := &WebhookClient{
+		name:     name,
 		client:   c,
 		webhooks: webhooks,
 	}
@@ -37,32 +53,91 @@ func NewWebhookClient(webhooks []string) *WebhookClient {
 	return client
 }
 
-func (c *WebhookClient) PushMessage(ctx context.Context, videos []job.Post) error {
-	message, err := BuildMessageCard(ctx, videos)
-	if err != nil {
-		return err
-	}
+// SetTemplate pushes cards filled from hosted templates instead of code built
+// cards.
+func (c *WebhookClient) SetTemplate(template *Template) {
+	c.template = template
+}
 
+// SetUploader uploads the pictures of the cards with the Lark app of client,
+// the cards have no pictures without one.
+func (c *WebhookClient) SetUploader(client *Client) {
+	c.uploader = client
+}
+
+// webhookResponse is the reply of a custom bot webhook, which fails with a
+// non zero code even when answered with 200 OK.
+type webhookResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// webhookKey identifies a webhook in the deliveries without storing its
+// secret url.
+func webhookKey(webhook string) string {
+	sum := sha256.Sum256([]byte(webhook))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Targets returns the keys of the webhooks.
+func (c *WebhookClient) Targets(ctx context.Context) ([]string, error) {
+	return lo.Map(c.webhooks, func(webhook string, _ int) string {
+		return webhookKey(webhook)
+	}), nil
+}
+
+// PushMessage pushes videos to every webhook. It fails unless every webhook
+// was pushed.
+func (c *WebhookClient) PushMessage(ctx context.Context, videos []job.Post) error {
+	var errs []error
 	for _, webhook := range c.webhooks {
-		resp, err := c.client.R().
-			SetContext(ctx).
-			SetHeader("Content-Type", "application/json").
-			SetBody(ChatContent{
-				MsgType: larkim.MsgTypeInteractive,
-				Card:    message,
-			}).
-			Post(webhook)
-		if err != nil {
-			logrus.Errorf("failed to post webhook: %v", err)
-			continue
+		if err := c.push(ctx, webhook, videos); err != nil {
+			errs = append(errs, err)
 		}
+	}
 
-		if !resp.IsSuccess() {
-			logrus.Error(errors.Wrapf(err, "lark push message failed: %s", resp.String()))
-		}
+	return errors2.Join(errs...)
+}
+
+// PushMessageTo pushes videos to the webhook with key target. Webhooks no
+// longer configured are skipped.
+func (c *WebhookClient) PushMessageTo(ctx context.Context, target string, videos []job.Post) error {
+	webhook, ok := lo.Find(c.webhooks, func(webhook string) bool {
+		return webhookKey(webhook) == target
+	})
+	if !ok {
+		logrus.Warnf("%s: skipped deliveries of removed webhook %s", c.name, target)
+		return nil
+	}
+
+	return c.push(ctx, webhook, videos)
+}
+
+func (c *WebhookClient) push(ctx context.Context, webhook string, videos []job.Post) error {
+	// Webhook bots cannot receive card callbacks, so their cards have no
+	// actions.
+	message := buildMessageCard(ctx, c.uploader, c.template, videos, false)
+
+	var result webhookResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(ChatContent{
+			MsgType: larkim.MsgTypeInteractive,
+			Card:    message,
+		}).
+		SetResult(&result).
+		Post(webhook)
+	if err != nil {
+		logrus.Errorf("failed to post webhook: %v", err)
+		return errors.Wrapf(err, "failed to post webhook %s", webhookKey(webhook))
+	}
 
-		logrus.Infof("successfully pushed message to webhook: %s", webhook)
+	if !resp.IsSuccess() || result.Code != 0 {
+		logrus.Errorf("lark push message failed: %s", resp.String())
+		return errors.Errorf("lark push message to webhook %s failed: %s %d %s", webhookKey(webhook), resp.Status(), result.Code, result.Msg)
 	}
 
+	logrus.Infof("successfully pushed message to webhook: %s", webhookKey(webhook))
 	return nil
 }
@@ -0,0 +1,59 @@
+package lark
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+func TestWebhookTargets(t *testing.T) {
+	var okCalls, failCalls atomic.Int32
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		okCalls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"StatusCode":0,"StatusMessage":"success","code":0,"data":{},"msg":"success"}`))
+	}))
+	defer ok.Close()
+	// Lark answers failed pushes with 200 OK and a non zero code.
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failCalls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":19021,"data":{},"msg":"sign match fail or timestamp is not within one hour from current time"}`))
+	}))
+	defer failing.Close()
+
+	client := NewWebhookClient(WebhookModule, []string{ok.URL, failing.URL})
+	client.client.SetRetryCount(0)
+	videos := []job.Post{testPost{source: "bilibili", title: "RMUC 工程机器人兑换"}}
+	ctx := context.Background()
+
+	targets, _ := client.Targets(ctx)
+	if len(targets) != 2 || targets[0] == targets[1] || slices.Contains(targets, ok.URL) {
+		t.Fatalf("unexpected targets %v", targets)
+	}
+
+	if err := client.PushMessage(ctx, videos); err == nil {
+		t.Fatal("push with a failing webhook succeeded")
+	}
+
+	// Pushing to a target reaches that webhook only.
+	if err := client.PushMessageTo(ctx, targets[1], videos); err == nil {
+		t.Fatal("push to the failing webhook succeeded")
+	}
+	if err := client.PushMessageTo(ctx, targets[0], videos); err != nil {
+		t.Fatal(err)
+	}
+	if okCalls.Load() != 2 || failCalls.Load() != 2 {
+		t.Errorf("webhooks were called %d and %d times, want 2 and 2", okCalls.Load(), failCalls.Load())
+	}
+
+	// Deliveries of removed webhooks are dropped.
+	if err := client.PushMessageTo(ctx, "removed", videos); err != nil {
+		t.Fatal(err)
+	}
+}
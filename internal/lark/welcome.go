@@ -0,0 +1,142 @@
+package lark
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/ent"
+	"github.com/wintbiit/rmtv/ent/post"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+const (
+	welcomeTimeout = time.Minute
+	// digestScan bounds the recent posts looked at to fill a digest.
+	digestScan = 100
+)
+
+// SetRoutes makes the client follow the routes of the scan job when picking
+// posts for a chat. destinations maps destination names to their chats.
+func (c *Client) SetRoutes(routes []job.Route, destinations map[string][]string) {
+	c.routes = routes
+	c.destinations = destinations
+}
+
+// SetDigestCount sets how many recent posts are sent to a chat the bot is
+// added to, 0 sends only the welcome card.
+func (c *Client) SetDigestCount(count int) {
+	c.digestCount = count
+}
+
+// routed reports whether the scan job delivers item to chat, either through
+// the broadcast consumer or a destination of the chat.
+func (c *Client) routed(chat string, item job.SourcedPost) bool {
+	if len(c.routes) == 0 {
+		return true
+	}
+
+	return lo.SomeBy(c.routes, func(route job.Route) bool {
+		return route.Match(item.GetSource(), item) && lo.SomeBy(route.Consumers, func(name string) bool {
+			return name == Module || lo.Contains(c.destinations[name], chat)
+		})
+	})
+}
+
+func (c *Client) onBotAdded(ctx context.Context, header *EventHeader, event *larkim.P2ChatMemberBotAddedV1Data) (any, error) {
+	chat := lo.FromPtr(event.ChatId)
+	if chat == "" {
+		return nil, nil
+	}
+
+	// Uploading the digest images may take longer than Lark waits for the
+	// callback to answer.
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), welcomeTimeout)
+		defer cancel()
+
+		if err := c.welcome(ctx, chat); err != nil {
+			logrus.Errorf("failed to welcome chat %s: %v", chat, err)
+		}
+	}()
+
+	return nil, nil
+}
+
+// welcome introduces the bot to a chat and sends a digest of the latest
+// posts it would have received.
+func (c *Client) welcome(ctx context.Context, chat string) error {
+	posts, err := c.digest(ctx, chat)
+	if err != nil {
+		return err
+	}
+
+	content, _ := json.Marshal(welcomeCard(len(posts)))
+	if err := c.PushMessageToChat(ctx, chat, string(content)); err != nil {
+		return err
+	}
+
+	if len(posts) == 0 {
+		return nil
+	}
+
+	content, _ = json.Marshal(buildMessageCard(ctx, c, c.template, posts, true))
+
+	return c.pushCard(ctx, chat, string(content), posts)
+}
+
+// digest returns the latest posts routed to chat and accepted by its
+// subscription, if it has one from an earlier stay.
+func (c *Client) digest(ctx context.Context, chat string) ([]job.Post, error) {
+	if c.db == nil || c.digestCount <= 0 {
+		return nil, nil
+	}
+
+	recent, err := c.db.Post.Query().
+		Order(ent.Desc(post.FieldCreatedAt)).
+		Limit(digestScan).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query recent posts")
+	}
+
+	sub, err := c.db.Subscription.Get(ctx, chat)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "failed to get subscription of %s", chat)
+	}
+
+	posts := make([]job.Post, 0, c.digestCount)
+	for _, item := range recent {
+		p := job.NewStoredPost(item)
+		if c.routed(chat, p) && accepts(sub, p) {
+			posts = append(posts, p)
+		}
+		if len(posts) == c.digestCount {
+			break
+		}
+	}
+
+	return posts, nil
+}
+
+func welcomeCard(count int) *Card {
+	text := "大家好，我会把 RoboMaster 相关的新视频、论坛帖子与答疑推送到本群。\n\n" + commandUsage
+	if count > 0 {
+		text += "\n\n以下是最近的 " + strconv.Itoa(count) + " 条内容："
+	}
+
+	return &Card{
+		Schema: "2.0",
+		Config: CardConfig{WidthMode: "fill"},
+		Header: &CardHeader{
+			Title:    PlainText("RoboMaster TV"),
+			Template: "blue",
+		},
+		Body: CardBody{Elements: []CardElement{Markdown{Content: text}}},
+	}
+}
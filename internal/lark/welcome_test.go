@@ -0,0 +1,70 @@
+package lark
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+func TestWelcomeCard(t *testing.T) {
+	golden(t, "welcome.json", welcomeCard(0))
+	golden(t, "welcome_digest.json", welcomeCard(5))
+}
+
+func TestDigest(t *testing.T) {
+	c := newTestDbClient(t)
+	ctx := context.Background()
+
+	// Oldest first, so BV5 is the latest post.
+	created := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	for _, p := range [][3]string{
+		{"bilibili", "BV1", "上海交通大学"},
+		{"bilibili", "BV2", "上海交通大学"},
+		{"bilibili", "BV3", "搬运工"},
+		{"rmbbs", "R4", "华南理工大学"},
+		{"bilibili", "BV5", "上海交通大学"},
+	} {
+		createPost(t, c, p[0], p[1], p[2])
+		created = created.Add(time.Minute)
+		c.db.Post.UpdateOneID(p[1]).SetCreatedAt(created).ExecX(ctx)
+	}
+
+	digest := func(chat string) []string {
+		posts, err := c.digest(ctx, chat)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return lo.Map(posts, func(item job.Post, _ int) string {
+			return item.GetId()
+		})
+	}
+	check := func(step, chat string, want ...string) {
+		if got := digest(chat); !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", step, got, want)
+		}
+	}
+
+	if got := digest("oc_1"); len(got) != 0 {
+		t.Fatalf("digest without a count: %v", got)
+	}
+
+	c.SetDigestCount(3)
+	check("latest", "oc_1", "BV5", "R4", "BV3")
+
+	// Forum posts only reach the chat of the team destination.
+	c.SetRoutes([]job.Route{
+		{Sources: []string{"bilibili"}, Consumers: []string{Module}},
+		{Sources: []string{"rmbbs"}, Consumers: []string{"team"}},
+	}, map[string][]string{"team": {"oc_2"}})
+	check("routes", "oc_1", "BV5", "BV3", "BV2")
+	check("destination", "oc_2", "BV5", "R4", "BV3")
+
+	c.db.Subscription.Create().SetID("oc_1").SetMutedAuthors([]string{"搬运工"}).ExecX(ctx)
+	c.db.Subscription.Create().SetID("oc_2").SetSources([]string{"rmbbs"}).ExecX(ctx)
+	check("muted author", "oc_1", "BV5", "BV2", "BV1")
+	check("subscribed source", "oc_2", "R4")
+}
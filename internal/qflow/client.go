@@ -1,13 +1,14 @@
 package qflow
 
 import (
+	errors2 "errors"
 	"fmt"
 	"net/http"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
 	"github.com/wintbiit/rmtv/internal/job"
@@ -22,39 +23,94 @@ const (
 )
 
 type Client struct {
-	client *resty.Client
-	appId  string
-	baseId string
+	name     string
+	client   *resty.Client
+	appId    string
+	baseId   string
+	form     Form
+	maxPages int
+
+	// backfillPages caps the walk of a backfill instead of maxPages, as a
+	// backfill usually reaches much further back than a scan.
+	backfillPages int
 }
 
 const Module = "qflow"
 
+func init() {
+	job.Register(Module, "QFLOW_", "轻流表单问答", DefaultConfig, (*Config).Validate, func(name string, config *Config) (job.MessageProvider, error) {
+		return NewClient(name, config)
+	})
+}
+
 func (c *Client) Name() string {
-	return Module
+	return c.name
+}
+
+type Config struct {
+	Cookies  string `yaml:"cookies" env:"COOKIES"`
+	AppId    string `yaml:"app_id" env:"APP_ID"`
+	BaseId   string `yaml:"base_id" env:"BASE_ID"`
+	FormFile string `yaml:"form_file" env:"FORM_FILE"`
+	MaxPages int    `yaml:"max_pages" env:"MAX_PAGES"`
+	// BackfillMaxPages caps the pages walked by a backfill.
+	BackfillMaxPages int `yaml:"backfill_max_pages" env:"BACKFILL_MAX_PAGES"`
 }
 
-func NewClient() *Client {
-	cookiesRaw, ok := os.LookupEnv("QFLOW_COOKIES")
-	if !ok {
-		logrus.Fatalf("env variable COOKIES not set")
+func DefaultConfig() *Config {
+	return &Config{
+		MaxPages:         5,
+		BackfillMaxPages: 50,
 	}
+}
 
-	cookies, err := http.ParseCookie(cookiesRaw)
-	if err != nil {
-		logrus.Fatalf("failed to parse cookies: %v", err)
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Cookies == "" {
+		errs = append(errs, errors.New("cookies is required"))
+	} else if _, err := http.ParseCookie(c.Cookies); err != nil {
+		errs = append(errs, errors.Wrap(err, "invalid cookies"))
+	}
+	if c.AppId == "" {
+		errs = append(errs, errors.New("app id is required"))
+	}
+	if c.BaseId == "" {
+		errs = append(errs, errors.New("base id is required"))
+	}
+	if _, err := c.form(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.MaxPages <= 0 {
+		errs = append(errs, errors.Errorf("invalid max pages %d", c.MaxPages))
+	}
+	if c.BackfillMaxPages <= 0 {
+		errs = append(errs, errors.Errorf("invalid backfill max pages %d", c.BackfillMaxPages))
 	}
 
-	qflowAppId, ok := os.LookupEnv("QFLOW_APP_ID")
-	if !ok {
-		logrus.Fatalf("env variable QFLOW_APP_ID not set")
+	return errors2.Join(errs...)
+}
+
+func (c *Config) form() (Form, error) {
+	if c.FormFile == "" {
+		return DefaultForm(), nil
 	}
 
-	qflowBaseId, ok := os.LookupEnv("QFLOW_BASE_ID")
-	if !ok {
-		logrus.Fatalf("env variable QFLOW_BASE_ID not set")
+	return LoadForm(c.FormFile)
+}
+
+func NewClient(name string, config *Config) (*Client, error) {
+	cookies, err := http.ParseCookie(config.Cookies)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse cookies")
+	}
+
+	form, err := config.form()
+	if err != nil {
+		return nil, err
 	}
 
 	c := resty.New().
+		SetBaseURL("https://qingflow.com/").
 		SetRetryCount(3).
 		SetRetryMaxWaitTime(5*1000).
 		SetRetryWaitTime(1*1000).
@@ -65,13 +121,17 @@ func NewClient() *Client {
 		SetCookies(cookies).
 		AddRequestMiddleware(limiter(ratelimit.New(3, ratelimit.Per(time.Minute))))
 
-	logrus.Infof("Initialized QFlow client")
+	logrus.Infof("Initialized QFlow client %s with form %s", name, form.Label)
 
 	return &Client{
-		client: c,
-		appId:  qflowAppId,
-		baseId: qflowBaseId,
-	}
+		name:          name,
+		client:        c,
+		appId:         config.AppId,
+		baseId:        config.BaseId,
+		form:          form,
+		maxPages:      config.MaxPages,
+		backfillPages: config.BackfillMaxPages,
+	}, nil
 }
 
 func limiter(limiter ratelimit.Limiter) resty.RequestMiddleware {
@@ -93,10 +153,11 @@ type Answer struct {
 	CreatedAt   time.Time // 申请时间
 	UpdatedAt   time.Time // 更新时间
 	URL         string
+	Label       string
 }
 
 func (m *Answer) GetType() string {
-	return "轻流"
+	return m.Label
 }
 
 func (m *Answer) GetTypeColor() string {
@@ -124,11 +185,11 @@ func clearEng(s string) string {
 }
 
 func (m *Answer) GetTags() []string {
-	return []string{
+	return lo.Compact([]string{
 		clearEng(m.Competition),
 		clearEng(m.Source),
 		clearEng(m.Status),
-	}
+	})
 }
 
 func (m *Answer) GetPubDate() time.Time {
@@ -151,12 +212,78 @@ func (m *Answer) GetExtra() job.PostExtra {
 	return nil
 }
 
+func (m *Answer) GetSnapshot() map[string]string {
+	return map[string]string{
+		"流程状态": clearEng(m.Status),
+		"回答":   m.Answer,
+	}
+}
+
 func (c *Client) Collect() ([]job.Post, error) {
+	return c.CollectSince(time.Time{})
+}
+
+// CollectSince walks the records of the view until it reaches records updated
+// before since, the last page or the page cap. Records that cannot be parsed
+// are skipped and reported in a job.PartialError.
+func (c *Client) CollectSince(since time.Time) ([]job.Post, error) {
+	return c.collect(since, c.maxPages)
+}
+
+// BackfillSince is CollectSince with the backfill page cap.
+func (c *Client) BackfillSince(since time.Time) ([]job.Post, error) {
+	return c.collect(since, c.backfillPages)
+}
+
+func (c *Client) collect(since time.Time, maxPages int) ([]job.Post, error) {
+	answers := make([]job.Post, 0)
+	var errs []error
+	for page := 1; ; page++ {
+		records, err := c.listPage(page)
+		if err != nil {
+			return nil, err
+		}
+
+		var last *Answer
+		for _, r := range records {
+			answer, err := c.parse(r)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			answers = append(answers, answer)
+			last = answer
+		}
+
+		if len(records) < c.form.PageSize {
+			break
+		}
+
+		if last != nil && !last.UpdatedAt.After(since) {
+			break
+		}
+
+		if page >= maxPages {
+			if !since.IsZero() {
+				logrus.Warnf("Stopped walking %s at page %d before reaching %s", c.name, page, since.Format(time.DateOnly))
+			}
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return answers, &job.PartialError{Errs: errs}
+	}
+
+	return answers, nil
+}
+
+func (c *Client) listPage(page int) ([]gjson.Result, error) {
 	resp, err := c.client.R().
-		SetBody(`{"filter":{"pageSize":50,"pageNum":1,"type":8,"sorts":[{"queId":3,"queType":4,"isAscend":false}],"queries":[],"queryKey":null}}`).
+		SetBody(c.form.request(page)).
 		SetContentType("application/json").
 		SetPathParam("id", c.baseId).
-		Post("https://qingflow.com/api/view/{id}/apply/filter")
+		Post("api/view/{id}/apply/filter")
 	if err != nil {
 		return nil, err
 	}
@@ -170,31 +297,32 @@ func (c *Client) Collect() ([]job.Post, error) {
 		return nil, fmt.Errorf("failed to collect qflow: %s", resp.String())
 	}
 
-	answers := make([]job.Post, len(result.Get("data.list").Array()))
-	for i, r := range result.Get("data.list").Array() {
-		createdAt, err := time.Parse(time.DateTime, r.Get(`answers.#(queTitle%"*申请时间*").values.0.value`).String())
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse created at: %s", r.String())
-		}
-		updatedAt, err := time.Parse(time.DateTime, r.Get(`answers.#(queTitle%"*更新时间*").values.0.value`).String())
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse updated at: %s", r.String())
-		}
+	return result.Get("data.list").Array(), nil
+}
 
-		answers[i] = &Answer{
-			ID:          r.Get("applyId").String(),
-			Status:      r.Get(`answers.#(queTitle%"*状态*").values.0.value`).String(),
-			University:  r.Get(`answers.#(queTitle%"*University*").values.0.value`).String(),
-			Team:        r.Get(`answers.#(queTitle%"*Team*").values.0.value`).String(),
-			Competition: r.Get(`answers.#(queTitle%"*Competition*").values.0.value`).String(),
-			Source:      r.Get(`answers.#(queTitle%"*问题来源及手册*").values.0.value`).String(),
-			Question:    r.Get(`answers.#(queTitle%"*描述你的问题*").values.0.value`).String(),
-			Answer:      r.Get(`answers.#(queTitle%"*Answer*").values.0.value`).String(),
-			CreatedAt:   createdAt,
-			UpdatedAt:   updatedAt,
-			URL:         fmt.Sprintf("https://qingflow.com/appView/%s/shareView/%s?applyId=%s", c.appId, c.baseId, r.Get("applyId").String()),
-		}
+func (c *Client) parse(r gjson.Result) (*Answer, error) {
+	id := r.Get("applyId").String()
+	createdAt, err := c.form.parseTime(r, FieldCreatedAt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "record %s", id)
+	}
+	updatedAt, err := c.form.parseTime(r, FieldUpdatedAt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "record %s", id)
 	}
 
-	return answers, nil
+	return &Answer{
+		ID:          id,
+		Status:      c.form.value(r, FieldStatus),
+		University:  c.form.value(r, FieldUniversity),
+		Team:        c.form.value(r, FieldTeam),
+		Competition: c.form.value(r, FieldCompetition),
+		Source:      c.form.value(r, FieldSource),
+		Question:    c.form.value(r, FieldQuestion),
+		Answer:      c.form.value(r, FieldAnswer),
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+		URL:         fmt.Sprintf("https://qingflow.com/appView/%s/shareView/%s?applyId=%s", c.appId, c.baseId, id),
+		Label:       c.form.Label,
+	}, nil
 }
@@ -0,0 +1,168 @@
+package qflow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wintbiit/rmtv/internal/job"
+	"resty.dev/v3"
+)
+
+func newTestClient(t *testing.T, fixture string, requests *[]filterRequest) *Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/view/base/apply/filter" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		var req filterRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		*requests = append(*requests, req)
+
+		data, err := os.ReadFile(filepath.Join("testdata", fmt.Sprintf("%s_%d.json", fixture, req.Filter.PageNum)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+
+	form := DefaultForm()
+	form.PageSize = 2
+
+	return &Client{
+		client:   resty.New().SetBaseURL(srv.URL + "/"),
+		appId:    "app",
+		baseId:   "base",
+		form:     form,
+		maxPages: 5,
+	}
+}
+
+func TestCollectSince(t *testing.T) {
+	var requests []filterRequest
+	client := newTestClient(t, "page", &requests)
+
+	since := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	posts, err := client.CollectSince(since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The second page reaches the watermark, the third is never requested.
+	if len(requests) != 2 {
+		t.Fatalf("unexpected page count: %d", len(requests))
+	}
+	if requests[1].Filter.PageSize != 2 || requests[1].Filter.Sorts[0].QueId != 3 {
+		t.Fatalf("unexpected filter: %+v", requests[1].Filter)
+	}
+	if len(posts) != 4 {
+		t.Fatalf("unexpected post count: %d", len(posts))
+	}
+
+	answer := posts[0].(*Answer)
+	if answer.ID != "1001" || answer.Question != "问题 1" || answer.Answer != "回答 1" || answer.Team != "测试战队" {
+		t.Fatalf("unexpected answer: %+v", answer)
+	}
+	if answer.GetType() != "轻流" || !answer.UpdatedAt.Equal(time.Date(2025, 3, 5, 4, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected answer: %+v", answer)
+	}
+}
+
+func TestCollectAllPages(t *testing.T) {
+	var requests []filterRequest
+	client := newTestClient(t, "page", &requests)
+
+	posts, err := client.Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 3 || len(posts) != 5 {
+		t.Fatalf("unexpected result: %d pages, %d posts", len(requests), len(posts))
+	}
+}
+
+func TestBackfillSince(t *testing.T) {
+	var requests []filterRequest
+	client := newTestClient(t, "page", &requests)
+	client.maxPages = 1
+	client.backfillPages = 5
+
+	since := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	if _, err := client.CollectSince(since); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("unexpected page count of scan: %d", len(requests))
+	}
+
+	// A backfill walks past the scan page cap until it reaches since.
+	requests = nil
+	posts, err := client.BackfillSince(since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 2 || len(posts) != 4 {
+		t.Fatalf("unexpected backfill: %d pages, %d posts", len(requests), len(posts))
+	}
+}
+
+func TestLoadForm(t *testing.T) {
+	form, err := LoadForm(filepath.Join("testdata", "form.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if form.Label != "裁判系统反馈" || form.PageSize != 20 || form.Type != 8 {
+		t.Fatalf("unexpected form: %+v", form)
+	}
+	if form.Fields[FieldQuestion] != "*问题描述*" || form.Fields[FieldAnswer] != "*Answer*" || form.Fields[FieldTeam] != "" {
+		t.Fatalf("unexpected fields: %v", form.Fields)
+	}
+
+	bad := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(bad, []byte(`{"fields":{"title":"*标题*"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadForm(bad); err == nil {
+		t.Fatal("unknown field is accepted")
+	}
+}
+
+func TestCollectSkipsBadRecords(t *testing.T) {
+	var requests []filterRequest
+	client := newTestClient(t, "bad", &requests)
+	client.form.PageSize = 3
+	client.maxPages = 1
+
+	posts, err := client.Collect()
+
+	var partial *job.PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(partial.Errs) != 1 || !strings.Contains(partial.Errs[0].Error(), `record 2003: invalid updated_at "昨天"`) {
+		t.Fatalf("unexpected skipped records: %v", partial.Errs)
+	}
+
+	if len(posts) != 2 {
+		t.Fatalf("unexpected post count: %d", len(posts))
+	}
+	for _, post := range posts {
+		if !post.GetPubDate().Equal(time.Date(2025, 3, 5, 4, 0, 0, 0, time.UTC)) {
+			t.Fatalf("unexpected pub date of %s: %v", post.GetId(), post.GetPubDate())
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package qflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	FieldStatus      = "status"
+	FieldUniversity  = "university"
+	FieldTeam        = "team"
+	FieldCompetition = "competition"
+	FieldSource      = "source"
+	FieldQuestion    = "question"
+	FieldAnswer      = "answer"
+	FieldCreatedAt   = "created_at"
+	FieldUpdatedAt   = "updated_at"
+)
+
+var fields = []string{
+	FieldStatus, FieldUniversity, FieldTeam, FieldCompetition, FieldSource,
+	FieldQuestion, FieldAnswer, FieldCreatedAt, FieldUpdatedAt,
+}
+
+type Sort struct {
+	QueId    int  `json:"queId"`
+	QueType  int  `json:"queType"`
+	IsAscend bool `json:"isAscend"`
+}
+
+// Form describes how records of a qingflow view are listed and mapped onto
+// answers. Fields maps answer fields to gjson patterns matching the queTitle
+// of the form question holding them. The sort must be on the updated time,
+// descending, for incremental collection to stop at the watermark.
+type Form struct {
+	Label    string            `json:"label"`
+	Type     int               `json:"type"`
+	PageSize int               `json:"page_size"`
+	Sorts    []Sort            `json:"sorts"`
+	Queries  json.RawMessage   `json:"queries"`
+	Fields   map[string]string `json:"fields"`
+	// Timezone applies to times without an offset, China Standard Time by
+	// default.
+	Timezone string `json:"timezone"`
+
+	location *time.Location
+}
+
+// DefaultForm is the RoboMaster rule Q&A form.
+func DefaultForm() Form {
+	return Form{
+		Label:    "轻流",
+		Type:     8,
+		PageSize: 50,
+		Sorts:    []Sort{{QueId: 3, QueType: 4, IsAscend: false}},
+		Queries:  json.RawMessage("[]"),
+		Fields: map[string]string{
+			FieldStatus:      "*状态*",
+			FieldUniversity:  "*University*",
+			FieldTeam:        "*Team*",
+			FieldCompetition: "*Competition*",
+			FieldSource:      "*问题来源及手册*",
+			FieldQuestion:    "*描述你的问题*",
+			FieldAnswer:      "*Answer*",
+			FieldCreatedAt:   "*申请时间*",
+			FieldUpdatedAt:   "*更新时间*",
+		},
+	}
+}
+
+// LoadForm reads a form from a JSON file. Missing settings and fields keep
+// the values of DefaultForm.
+func LoadForm(path string) (Form, error) {
+	form := DefaultForm()
+	defaults := form.Fields
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return form, errors.Wrap(err, "failed to read form")
+	}
+
+	form.Fields = nil
+	if err := json.Unmarshal(data, &form); err != nil {
+		return form, errors.Wrap(err, "failed to parse form")
+	}
+
+	form.Fields = lo.Assign(defaults, form.Fields)
+
+	if form.Timezone != "" {
+		form.location, err = time.LoadLocation(form.Timezone)
+		if err != nil {
+			return form, errors.Wrap(err, "invalid timezone in form")
+		}
+	}
+
+	return form, form.validate()
+}
+
+func (f *Form) validate() error {
+	for field := range f.Fields {
+		if !lo.Contains(fields, field) {
+			return errors.Errorf("unknown field %q in form", field)
+		}
+	}
+	if f.PageSize <= 0 {
+		return errors.Errorf("invalid page size %d in form", f.PageSize)
+	}
+
+	return nil
+}
+
+type filterRequest struct {
+	Filter struct {
+		PageSize int             `json:"pageSize"`
+		PageNum  int             `json:"pageNum"`
+		Type     int             `json:"type"`
+		Sorts    []Sort          `json:"sorts"`
+		Queries  json.RawMessage `json:"queries"`
+		QueryKey *string         `json:"queryKey"`
+	} `json:"filter"`
+}
+
+func (f *Form) request(page int) filterRequest {
+	var req filterRequest
+	req.Filter.PageSize = f.PageSize
+	req.Filter.PageNum = page
+	req.Filter.Type = f.Type
+	req.Filter.Sorts = f.Sorts
+	req.Filter.Queries = f.Queries
+	if len(req.Filter.Queries) == 0 {
+		req.Filter.Queries = json.RawMessage("[]")
+	}
+
+	return req
+}
+
+// value returns the first value of the question mapped to field, or "" when
+// the field is not mapped.
+func (f *Form) value(record gjson.Result, field string) string {
+	pattern, ok := f.Fields[field]
+	if !ok || pattern == "" {
+		return ""
+	}
+
+	return record.Get(fmt.Sprintf(`answers.#(queTitle%%%q).values.0.value`, pattern)).String()
+}
+
+var chinaTime = time.FixedZone("CST", 8*60*60)
+
+// timeLayouts are tried in order. Layouts with an offset keep it, the others
+// are read in the form's timezone.
+var timeLayouts = []string{
+	time.DateTime,
+	"2006-01-02 15:04",
+	"2006/01/02 15:04:05",
+	"2006/01/02 15:04",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 -0700",
+	time.DateOnly,
+	"2006/01/02",
+}
+
+// parseTime reads the time mapped to field, which may also be a unix
+// timestamp in seconds or milliseconds.
+func (f *Form) parseTime(record gjson.Result, field string) (time.Time, error) {
+	raw := strings.TrimSpace(f.value(record, field))
+
+	location := f.location
+	if location == nil {
+		location = chinaTime
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, raw, location); err == nil {
+			return t, nil
+		}
+	}
+
+	if n, err := strconv.ParseInt(raw, 10, 64); err == nil && n > 0 {
+		if n > 1e12 {
+			return time.UnixMilli(n), nil
+		}
+		return time.Unix(n, 0), nil
+	}
+
+	return time.Time{}, errors.Errorf("invalid %s %q", field, raw)
+}
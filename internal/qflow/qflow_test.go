@@ -1,6 +1,7 @@
 package qflow
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"testing"
@@ -11,7 +12,20 @@ import (
 )
 
 func TestCollectQFlow(t *testing.T) {
-	client := NewClient()
+	cookies, ok := os.LookupEnv("QFLOW_COOKIES")
+	if !ok {
+		t.Skip("QFLOW_COOKIES is not set")
+	}
+
+	config := DefaultConfig()
+	config.Cookies = cookies
+	config.AppId = os.Getenv("QFLOW_APP_ID")
+	config.BaseId = os.Getenv("QFLOW_BASE_ID")
+	client, err := NewClient(Module, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	entries, err := client.Collect()
 	if err != nil {
 		t.Fatal(err)
@@ -30,10 +44,7 @@ func TestCollectQFlow(t *testing.T) {
 
 	larkClient := lark.NewClient(larkClientId, larkClientSecret)
 
-	card, err := lark.BuildMessageCard(t.Context(), entries[:5])
-	if err != nil {
-		t.Fatal(err)
-	}
+	card := lark.BuildCard(context.Background(), larkClient, entries[:5], false)
 
 	spew.Dump(card)
 
@@ -44,7 +55,7 @@ func TestCollectQFlow(t *testing.T) {
 
 	t.Log(string(data))
 
-	err = larkClient.PushMessageToChat(t.Context(), "oc_a9043042ede841a61ba05e9effdf60ca", string(data))
+	err = larkClient.PushMessageToChat(context.Background(), "oc_a9043042ede841a61ba05e9effdf60ca", string(data))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,68 @@
+package rmbbs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/internal/job"
+	"github.com/wintbiit/rmtv/utils"
+)
+
+const excerptLength = 200
+
+// PostDetail is a listed post together with its HTML body.
+type PostDetail struct {
+	ListPostsData
+	Content string `json:"content"`
+}
+
+func (c *Client) GetPost(id int) (*PostDetail, error) {
+	resp, err := c.client.R().
+		SetPathParam("id", strconv.Itoa(id)).
+		SetResult(Response[PostDetail]{}).
+		Get("/posts/{id}")
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, errors.New("failed to get post: " + resp.String())
+	}
+
+	response := resp.Result().(*Response[PostDetail])
+	if response.Code != 0 {
+		return nil, fmt.Errorf("failed to get post: %d %s", response.Code, response.Message)
+	}
+
+	return &response.Data, nil
+}
+
+// FetchContent loads the full body of a post and keeps it as sanitized HTML.
+// Posts over the fetch limit of the scan are left for later scans.
+func (c *Client) FetchContent(item job.Post) error {
+	l, ok := item.(*ListPostsData)
+	if !ok {
+		return errors.Errorf("unexpected post type %T", item)
+	}
+
+	if c.fetched >= c.fetchLimit {
+		logrus.Debugf("Deferred content of post %d over fetch limit", l.Id)
+		return nil
+	}
+	c.fetched++
+
+	detail, err := c.GetPost(l.Id)
+	if err != nil {
+		return err
+	}
+
+	l.Content = utils.SanitizeHTML(detail.Content)
+
+	return nil
+}
+
+func (l *ListPostsData) GetContent() string {
+	return l.Content
+}
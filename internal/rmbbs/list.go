@@ -8,24 +8,30 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/ent/schema"
 	"github.com/wintbiit/rmtv/internal/job"
+	"github.com/wintbiit/rmtv/utils"
 )
 
 const (
-	PostCategoryArticle = "ARTICLE"
-	StatePass           = "PASS"
+	PostCategoryArticle  = "ARTICLE"
+	PostCategoryQuestion = "QUESTION"
+	StatePass            = "PASS"
 )
 
+type ListPostsFilter struct {
+	Category    string        `json:"category"`
+	TagIds      []interface{} `json:"tagIds,omitempty"`
+	SortByViews bool          `json:"sortByViews,omitempty"`
+	Official    interface{}   `json:"official,omitempty"`
+	Marrow      interface{}   `json:"marrow,omitempty"`
+}
+
 type ListPostsRequest struct {
-	PageSize int `json:"pageSize"`
-	PageNo   int `json:"pageNo"`
-	Filter   struct {
-		Category    string        `json:"category"`
-		TagIds      []interface{} `json:"tagIds,omitempty"`
-		SortByViews bool          `json:"sortByViews,omitempty"`
-		Official    interface{}   `json:"official,omitempty"`
-		Marrow      interface{}   `json:"marrow,omitempty"`
-	} `json:"filter"`
+	PageSize int             `json:"pageSize"`
+	PageNo   int             `json:"pageNo"`
+	Filter   ListPostsFilter `json:"filter"`
 }
 
 type Tag struct {
@@ -60,6 +66,9 @@ type ListPostsData struct {
 	StateDesc      string      `json:"stateDesc"`
 	UpdateAt       time.Time   `json:"updateAt"`
 	WikiId         interface{} `json:"wikiId"`
+
+	// Content is the sanitized HTML body, only fetched for new posts.
+	Content string `json:"-"`
 }
 
 func (l *ListPostsData) GetType() string {
@@ -92,6 +101,10 @@ func (l *ListPostsData) GetTitle() string {
 }
 
 func (l *ListPostsData) GetDesc() string {
+	if text := utils.HTMLToText(l.Content); text != "" {
+		return utils.Excerpt(text, excerptLength)
+	}
+
 	return l.Introduction
 }
 
@@ -138,6 +151,53 @@ func (l *ListPostsData) GetExtra() job.PostExtra {
 	}
 }
 
+const snapshotSolution = "解答"
+
+func (l *ListPostsData) GetSnapshot() map[string]string {
+	if l.Category == PostCategoryQuestion {
+		return map[string]string{
+			"标题":             l.Title,
+			snapshotSolution: l.GetSolution(),
+		}
+	}
+
+	return map[string]string{
+		"标题": l.Title,
+		"简介": l.Introduction,
+	}
+}
+
+// NotifyChange announces a question becoming solved, whether or not update
+// notifications are enabled for the job.
+func (l *ListPostsData) NotifyChange(change schema.Change) bool {
+	return change.Field == snapshotSolution && change.Old == "" && change.New != ""
+}
+
+// GetSolution describes the accepted answer of a solved question as
+// "author: excerpt", or returns "" while it is unsolved.
+func (l *ListPostsData) GetSolution() string {
+	switch solution := l.Solution.(type) {
+	case nil:
+		return l.SolutionDesc
+	case map[string]interface{}:
+		author, _ := solution["authorNickname"].(string)
+		content, _ := solution["content"].(string)
+		text := utils.Excerpt(utils.HTMLToText(content), excerptLength)
+		if text == "" {
+			text = l.SolutionDesc
+		}
+		if author == "" {
+			return text
+		}
+		return fmt.Sprintf("%s: %s", author, text)
+	default:
+		if l.SolutionDesc != "" {
+			return l.SolutionDesc
+		}
+		return fmt.Sprint(solution)
+	}
+}
+
 func (l *ListPostsData) GetHeadImage() (string, error) {
 	type ImageData struct {
 		Alt string `json:"alt"`
@@ -166,10 +226,11 @@ type ListPostsResponse struct {
 	Size  int             `json:"size"`
 }
 
-func (c *Client) ListPosts(category string) ([]ListPostsData, error) {
+func (c *Client) ListPosts(category string, page int) (*ListPostsResponse, error) {
 	req := ListPostsRequest{
-		PageNo:   1,
-		PageSize: 10,
+		PageNo:   page,
+		PageSize: c.pageSize,
+		Filter:   c.filter,
 	}
 	req.Filter.Category = category
 	resp, err := c.client.R().
@@ -189,7 +250,43 @@ func (c *Client) ListPosts(category string) ([]ListPostsData, error) {
 		return nil, fmt.Errorf("failed to list posts: %d %s", response.Code, response.Message)
 	}
 
-	return lo.Filter(response.Data.List, func(item ListPostsData, index int) bool {
-		return item.State == StatePass
-	}), nil
+	return &response.Data, nil
+}
+
+// ListPostsSince walks the posts of category until it reaches posts created
+// before since, the last page or the page cap. Posts sorted by views are not
+// in time order, so only the first page is listed then.
+func (c *Client) ListPostsSince(category string, since time.Time) ([]ListPostsData, error) {
+	return c.listPostsSince(category, since, c.maxPages)
+}
+
+func (c *Client) listPostsSince(category string, since time.Time, maxPages int) ([]ListPostsData, error) {
+	results := make([]ListPostsData, 0)
+	for page := 1; ; page++ {
+		data, err := c.ListPosts(category, page)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, lo.Filter(data.List, func(item ListPostsData, index int) bool {
+			return item.State == StatePass
+		})...)
+
+		if c.filter.SortByViews || len(data.List) == 0 || page*c.pageSize >= data.Total {
+			break
+		}
+
+		if !data.List[len(data.List)-1].CreateAt.After(since) {
+			break
+		}
+
+		if page >= maxPages {
+			if !since.IsZero() {
+				logrus.Warnf("Stopped listing posts of category %s at page %d before reaching %s", category, page, since.Format(time.DateOnly))
+			}
+			break
+		}
+	}
+
+	return results, nil
 }
@@ -1,6 +1,36 @@
 package rmbbs
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/wintbiit/rmtv/ent/schema"
+)
 
 func TestListPosts(t *testing.T) {
 }
+
+func TestGetSolution(t *testing.T) {
+	question := &ListPostsData{Category: PostCategoryQuestion, Title: "裁判系统串口掉线"}
+	if solution := question.GetSnapshot()[snapshotSolution]; solution != "" {
+		t.Fatalf("unsolved question has solution %q", solution)
+	}
+
+	question.Solution = map[string]interface{}{
+		"authorNickname": "RM官方",
+		"content":        "<p>检查 <b>波特率</b> 设置</p>",
+	}
+	solution := question.GetSnapshot()[snapshotSolution]
+	if solution != "RM官方: 检查 波特率 设置" {
+		t.Fatalf("unexpected solution %q", solution)
+	}
+
+	if !question.NotifyChange(schema.Change{Field: snapshotSolution, Old: "", New: solution}) {
+		t.Error("solving a question is not notified")
+	}
+	if question.NotifyChange(schema.Change{Field: snapshotSolution, Old: solution, New: "RM官方: 已更新"}) {
+		t.Error("editing a solution is notified")
+	}
+	if question.NotifyChange(schema.Change{Field: "标题", Old: "", New: question.Title}) {
+		t.Error("title change is notified")
+	}
+}
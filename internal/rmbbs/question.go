@@ -0,0 +1,115 @@
+package rmbbs
+
+import (
+	"context"
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/wintbiit/rmtv/internal/job"
+)
+
+func (l *ListPostsData) isSolved() bool {
+	return l.Solution != nil || l.SolutionDesc != ""
+}
+
+// loadQuestions returns the tracked open questions and their creation time.
+func (c *Client) loadQuestions(ctx context.Context) (map[int]time.Time, error) {
+	if c.questions != nil {
+		return c.questions, nil
+	}
+
+	questions := make(map[int]time.Time)
+	if c.store != nil {
+		if _, err := c.store.Get(ctx, c.name+":questions", &questions); err != nil {
+			return nil, err
+		}
+	}
+
+	c.questions = questions
+	return questions, nil
+}
+
+// trackQuestions remembers the unsolved questions among listed and re-fetches
+// the tracked ones that are no longer listed, so a question solved after it
+// left the first pages is still noticed. Questions are tracked for trackFor
+// after creation.
+func (c *Client) trackQuestions(listed []job.Post) []job.Post {
+	ctx := context.Background()
+	loaded, err := c.loadQuestions(ctx)
+	if err != nil {
+		logrus.Errorf("Failed to load tracked questions: %v", err)
+		return nil
+	}
+	open := maps.Clone(loaded)
+
+	seen := make(map[int]bool)
+	for _, item := range listed {
+		question, ok := item.(*ListPostsData)
+		if !ok || question.Category != PostCategoryQuestion {
+			continue
+		}
+
+		seen[question.Id] = true
+		if question.isSolved() {
+			delete(open, question.Id)
+		} else {
+			open[question.Id] = question.CreateAt
+		}
+	}
+
+	results := make([]job.Post, 0)
+	for id, createdAt := range open {
+		if time.Since(createdAt) > c.trackFor {
+			delete(open, id)
+			continue
+		}
+		if seen[id] {
+			continue
+		}
+
+		detail, err := c.GetPost(id)
+		if err != nil {
+			logrus.Errorf("Failed to get tracked question %d: %v", id, err)
+			continue
+		}
+
+		question := detail.ListPostsData
+		if question.State != StatePass {
+			delete(open, id)
+			continue
+		}
+		if question.isSolved() {
+			delete(open, id)
+			results = append(results, &question)
+		}
+	}
+
+	c.pendingQuestions = open
+
+	logrus.Debugf("Tracking %d open questions: %v", len(open), lo.Map(lo.Keys(open), func(item int, _ int) string {
+		return strconv.Itoa(item)
+	}))
+
+	return results
+}
+
+// Commit saves the questions still open after the last collect, once the
+// solved ones are stored.
+func (c *Client) Commit(ctx context.Context) error {
+	if c.pendingQuestions == nil {
+		return nil
+	}
+
+	if c.store != nil {
+		if err := c.store.Put(ctx, c.name+":questions", c.pendingQuestions); err != nil {
+			return errors.Wrap(err, "failed to save tracked questions")
+		}
+	}
+
+	c.questions, c.pendingQuestions = c.pendingQuestions, nil
+	return nil
+}
@@ -0,0 +1,189 @@
+package rmbbs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/wintbiit/rmtv/ent/schema"
+	"github.com/wintbiit/rmtv/internal/job"
+	"resty.dev/v3"
+)
+
+// questionServer lists the questions in listed and serves the details in
+// posts, counting detail requests by id.
+type questionServer struct {
+	listed  []ListPostsData
+	posts   map[int]ListPostsData
+	fetched map[int]int
+}
+
+func (s *questionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == "/posts/list" {
+		json.NewEncoder(w).Encode(Response[ListPostsResponse]{
+			Success: true,
+			Data:    ListPostsResponse{List: s.listed, Total: len(s.listed), Size: 10},
+		})
+		return
+	}
+
+	id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/posts/"))
+	post, ok := s.posts[id]
+	if err != nil || !ok {
+		http.NotFound(w, r)
+		return
+	}
+	s.fetched[id]++
+	json.NewEncoder(w).Encode(Response[PostDetail]{Success: true, Data: PostDetail{ListPostsData: post}})
+}
+
+func newQuestionClient(t *testing.T, s *questionServer) *Client {
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	return &Client{
+		name:       Module,
+		categories: []string{PostCategoryQuestion},
+		pageSize:   10,
+		maxPages:   1,
+		trackFor:   7 * 24 * time.Hour,
+		client:     resty.New().SetBaseURL(srv.URL),
+	}
+}
+
+func question(id int, solution any, desc string) ListPostsData {
+	return ListPostsData{
+		Id:           id,
+		Category:     PostCategoryQuestion,
+		Title:        "裁判系统串口掉线 " + strconv.Itoa(id),
+		State:        StatePass,
+		CreateAt:     time.Now().Add(-time.Hour),
+		Solution:     solution,
+		SolutionDesc: desc,
+	}
+}
+
+func scanQuestions(t *testing.T, c *Client, commit bool) []job.Post {
+	posts, err := c.CollectSince(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commit {
+		if err := c.Commit(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return posts
+}
+
+func TestTrackQuestions(t *testing.T) {
+	solved := question(101, map[string]interface{}{
+		"authorNickname": "RM官方",
+		"content":        "<p>检查波特率</p>",
+	}, "")
+	s := &questionServer{
+		listed:  []ListPostsData{question(101, nil, "")},
+		posts:   map[int]ListPostsData{101: question(101, nil, "")},
+		fetched: make(map[int]int),
+	}
+	client := newQuestionClient(t, s)
+
+	// Listed questions are tracked without being fetched.
+	if posts := scanQuestions(t, client, true); len(posts) != 1 || s.fetched[101] != 0 {
+		t.Fatalf("listed scan: %d posts, %d fetches", len(posts), s.fetched[101])
+	}
+
+	// Once off the list, open questions are fetched until solved.
+	s.listed = nil
+	if posts := scanQuestions(t, client, true); len(posts) != 0 || s.fetched[101] != 1 {
+		t.Fatalf("open scan: %d posts, %d fetches", len(posts), s.fetched[101])
+	}
+
+	// A solved question that failed to store is fetched again.
+	s.posts[101] = solved
+	if posts := scanQuestions(t, client, false); len(posts) != 1 {
+		t.Fatalf("solved scan: %d posts", len(posts))
+	}
+	posts := scanQuestions(t, client, true)
+	if len(posts) != 1 || s.fetched[101] != 3 {
+		t.Fatalf("solved scan after failed store: %d posts, %d fetches", len(posts), s.fetched[101])
+	}
+	if solution := posts[0].(*ListPostsData).GetSnapshot()[snapshotSolution]; solution != "RM官方: 检查波特率" {
+		t.Fatalf("unexpected solution %q", solution)
+	}
+
+	// Solved questions are no longer tracked.
+	if posts := scanQuestions(t, client, true); len(posts) != 0 || s.fetched[101] != 3 {
+		t.Fatalf("scan after solved: %d posts, %d fetches", len(posts), s.fetched[101])
+	}
+}
+
+func TestTrackQuestionsSkipped(t *testing.T) {
+	expired := question(102, nil, "")
+	expired.CreateAt = time.Now().Add(-8 * 24 * time.Hour)
+	s := &questionServer{
+		listed: []ListPostsData{
+			question(101, nil, "已在评论区解答"),
+			expired,
+		},
+		posts: map[int]ListPostsData{
+			101: question(101, nil, "已在评论区解答"),
+			102: expired,
+		},
+		fetched: make(map[int]int),
+	}
+	client := newQuestionClient(t, s)
+	scanQuestions(t, client, true)
+
+	// Solved and expired questions are not tracked once off the list.
+	s.listed = nil
+	scanQuestions(t, client, true)
+	if len(s.fetched) != 0 {
+		t.Fatalf("untracked questions fetched: %v", s.fetched)
+	}
+}
+
+func TestSolutionTransitions(t *testing.T) {
+	unsolved := question(101, nil, "")
+	answered := question(101, nil, "已在评论区解答")
+	accepted := question(101, map[string]interface{}{
+		"authorNickname": "RM官方",
+		"content":        "<p>检查 <b>波特率</b> 设置</p>",
+	}, "")
+	edited := question(101, map[string]interface{}{
+		"authorNickname": "RM官方",
+		"content":        "<p>检查波特率与接线</p>",
+	}, "")
+
+	notified := func(prev, next ListPostsData) bool {
+		before, after := prev.GetSnapshot(), next.GetSnapshot()
+		return lo.SomeBy(lo.Keys(after), func(field string) bool {
+			return before[field] != after[field] && next.NotifyChange(schema.Change{Field: field, Old: before[field], New: after[field]})
+		})
+	}
+
+	cases := []struct {
+		name       string
+		prev, next ListPostsData
+		want       bool
+	}{
+		{"answered", unsolved, answered, true},
+		{"accepted", unsolved, accepted, true},
+		{"answer accepted", answered, accepted, false},
+		{"solution edited", accepted, edited, false},
+		{"unchanged", unsolved, unsolved, false},
+	}
+	for _, c := range cases {
+		if got := notified(c.prev, c.next); got != c.want {
+			t.Errorf("%s: notified = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
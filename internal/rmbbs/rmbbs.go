@@ -1,10 +1,12 @@
 package rmbbs
 
 import (
+	errors2 "errors"
 	"net/http"
-	"os"
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/samber/lo"
 	"github.com/samber/lo/parallel"
 	"github.com/sirupsen/logrus"
@@ -21,14 +23,39 @@ const (
 )
 
 type Client struct {
+	name       string
 	categories []string
+	filter     ListPostsFilter
+	pageSize   int
+	maxPages   int
+	trackFor   time.Duration
+	fetchLimit int
+	fetched    int
 	client     *resty.Client
+	store      *job.Store
+	// backfillPages caps the walk of a backfill instead of maxPages, as a
+	// backfill usually reaches much further back than a scan.
+	backfillPages int
+	// questions are the tracked open questions, pendingQuestions those left
+	// open by the last collect until its posts are stored.
+	questions        map[int]time.Time
+	pendingQuestions map[int]time.Time
 }
 
 const Module = "rmbbs"
 
+func init() {
+	job.Register(Module, "RMBBS_", "RoboMaster 论坛文章与问答", DefaultConfig, (*Config).Validate, func(name string, config *Config) (job.MessageProvider, error) {
+		return NewClient(name, config)
+	})
+}
+
 func (c *Client) Name() string {
-	return Module
+	return c.name
+}
+
+func (c *Client) SetStore(store *job.Store) {
+	c.store = store
 }
 
 type Response[T any] struct {
@@ -38,15 +65,86 @@ type Response[T any] struct {
 	Data    T      `json:"data"`
 }
 
-func NewClient() *Client {
-	cookiesRaw, ok := os.LookupEnv("RMBBS_COOKIES")
-	if !ok {
-		logrus.Fatalf("env variable COOKIES not set")
+type Config struct {
+	Cookies           string   `yaml:"cookies" env:"COOKIES"`
+	Categories        []string `yaml:"categories" env:"CATEGORIES"`
+	TagIds            []int    `yaml:"tag_ids" env:"TAG_IDS"`
+	Official          bool     `yaml:"official" env:"OFFICIAL"`
+	Marrow            bool     `yaml:"marrow" env:"MARROW"`
+	SortByViews       bool     `yaml:"sort_by_views" env:"SORT_BY_VIEWS"`
+	PageSize          int      `yaml:"page_size" env:"PAGE_SIZE"`
+	MaxPages          int      `yaml:"max_pages" env:"MAX_PAGES"`
+	QuestionTrackDays int      `yaml:"question_track_days" env:"QUESTION_TRACK_DAYS"`
+	// ContentFetchLimit bounds the post bodies fetched per scan, which share
+	// the rate limit with listing. Later scans fetch the rest.
+	ContentFetchLimit int `yaml:"content_fetch_limit" env:"CONTENT_FETCH_LIMIT"`
+	// BackfillMaxPages caps the pages walked by a backfill.
+	BackfillMaxPages int `yaml:"backfill_max_pages" env:"BACKFILL_MAX_PAGES"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Categories:        []string{PostCategoryArticle},
+		PageSize:          10,
+		MaxPages:          5,
+		QuestionTrackDays: 7,
+		ContentFetchLimit: 5,
+		BackfillMaxPages:  50,
+	}
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Cookies == "" {
+		errs = append(errs, errors.New("cookies is required"))
+	} else if _, err := http.ParseCookie(c.Cookies); err != nil {
+		errs = append(errs, errors.Wrap(err, "invalid cookies"))
+	}
+	for _, category := range c.Categories {
+		if !lo.Contains([]string{PostCategoryArticle, PostCategoryQuestion}, strings.ToUpper(category)) {
+			errs = append(errs, errors.Errorf("unknown category %q", category))
+		}
+	}
+	if c.PageSize <= 0 {
+		errs = append(errs, errors.Errorf("invalid page size %d", c.PageSize))
+	}
+	if c.MaxPages <= 0 {
+		errs = append(errs, errors.Errorf("invalid max pages %d", c.MaxPages))
+	}
+	if c.BackfillMaxPages <= 0 {
+		errs = append(errs, errors.Errorf("invalid backfill max pages %d", c.BackfillMaxPages))
+	}
+	if c.QuestionTrackDays <= 0 {
+		errs = append(errs, errors.Errorf("invalid question track days %d", c.QuestionTrackDays))
+	}
+	if c.ContentFetchLimit < 0 {
+		errs = append(errs, errors.Errorf("invalid content fetch limit %d", c.ContentFetchLimit))
 	}
 
-	cookies, err := http.ParseCookie(cookiesRaw)
+	return errors2.Join(errs...)
+}
+
+func NewClient(name string, config *Config) (*Client, error) {
+	cookies, err := http.ParseCookie(config.Cookies)
 	if err != nil {
-		logrus.Fatalf("failed to parse cookies: %v", err)
+		return nil, errors.Wrap(err, "failed to parse cookies")
+	}
+
+	categories := lo.Map(config.Categories, func(item string, _ int) string {
+		return strings.ToUpper(item)
+	})
+
+	filter := ListPostsFilter{
+		TagIds: lo.Map(config.TagIds, func(item int, _ int) interface{} {
+			return item
+		}),
+		SortByViews: config.SortByViews,
+	}
+	if config.Official {
+		filter.Official = true
+	}
+	if config.Marrow {
+		filter.Marrow = true
 	}
 
 	c := resty.New().
@@ -60,12 +158,19 @@ func NewClient() *Client {
 		SetCookies(cookies).
 		AddRequestMiddleware(limiter(ratelimit.New(3, ratelimit.Per(time.Minute))))
 
-	logrus.Infof("Initialized RMBBS client")
+	logrus.Infof("Initialized RMBBS client %s with categories: %v", name, categories)
 
 	return &Client{
-		categories: []string{PostCategoryArticle},
-		client:     c,
-	}
+		name:          name,
+		categories:    categories,
+		filter:        filter,
+		pageSize:      config.PageSize,
+		maxPages:      config.MaxPages,
+		backfillPages: config.BackfillMaxPages,
+		trackFor:      time.Duration(config.QuestionTrackDays) * 24 * time.Hour,
+		fetchLimit:    config.ContentFetchLimit,
+		client:        c,
+	}, nil
 }
 
 func limiter(limiter ratelimit.Limiter) resty.RequestMiddleware {
@@ -76,10 +181,24 @@ func limiter(limiter ratelimit.Limiter) resty.RequestMiddleware {
 }
 
 func (c *Client) Collect() ([]job.Post, error) {
+	return c.CollectSince(time.Time{})
+}
+
+func (c *Client) CollectSince(since time.Time) ([]job.Post, error) {
+	return c.collect(since, c.maxPages)
+}
+
+// BackfillSince is CollectSince with the backfill page cap.
+func (c *Client) BackfillSince(since time.Time) ([]job.Post, error) {
+	return c.collect(since, c.backfillPages)
+}
+
+func (c *Client) collect(since time.Time, maxPages int) ([]job.Post, error) {
+	c.fetched = 0
 	results := lo.Flatten(parallel.Map(c.categories, func(item string, index int) []job.Post {
-		result, err := c.ListPosts(item)
+		result, err := c.listPostsSince(item, since, maxPages)
 		if err != nil {
-			logrus.Errorf("Failed to search videos with keyword %s: %v", item, err)
+			logrus.Errorf("Failed to list posts of category %s: %v", item, err)
 			return nil
 		}
 		return lo.Map(result, func(item ListPostsData, index int) job.Post {
@@ -91,5 +210,9 @@ func (c *Client) Collect() ([]job.Post, error) {
 		return item.GetId()
 	})
 
+	if lo.Contains(c.categories, PostCategoryQuestion) {
+		results = append(results, c.trackQuestions(results)...)
+	}
+
 	return results, nil
 }
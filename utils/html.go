@@ -0,0 +1,309 @@
+package utils
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var allowedTags = map[atom.Atom][]string{
+	atom.P: nil, atom.Br: nil, atom.Hr: nil, atom.Div: nil, atom.Span: nil,
+	atom.B: nil, atom.Strong: nil, atom.I: nil, atom.Em: nil, atom.U: nil, atom.S: nil, atom.Del: nil,
+	atom.H1: nil, atom.H2: nil, atom.H3: nil, atom.H4: nil, atom.H5: nil, atom.H6: nil,
+	atom.Ul: nil, atom.Ol: nil, atom.Li: nil, atom.Blockquote: nil, atom.Pre: nil, atom.Code: nil,
+	atom.Table: nil, atom.Thead: nil, atom.Tbody: nil, atom.Tr: nil, atom.Th: nil, atom.Td: nil,
+	atom.Figure: nil, atom.Figcaption: nil,
+	atom.A:   {"href", "title"},
+	atom.Img: {"src", "alt", "title"},
+}
+
+// droppedTags are removed together with their content.
+var droppedTags = []atom.Atom{atom.Script, atom.Style, atom.Iframe, atom.Object, atom.Embed, atom.Noscript, atom.Template}
+
+var blockTags = []atom.Atom{
+	atom.P, atom.Br, atom.Hr, atom.Div, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6,
+	atom.Ul, atom.Ol, atom.Li, atom.Blockquote, atom.Pre, atom.Table, atom.Tr, atom.Figure,
+}
+
+func safeUrl(u string) bool {
+	u = strings.ToLower(strings.TrimSpace(u))
+	return strings.HasPrefix(u, "https://") || strings.HasPrefix(u, "http://") || strings.HasPrefix(u, "//")
+}
+
+// SanitizeHTML keeps a whitelist of formatting tags and http(s) links and
+// images, dropping scripts, styles, event handlers and everything else.
+func SanitizeHTML(s string) string {
+	var out strings.Builder
+	tokenizer := xhtml.NewTokenizer(strings.NewReader(s))
+	dropping := 0
+
+	for {
+		tt := tokenizer.Next()
+		if tt == xhtml.ErrorToken {
+			return out.String()
+		}
+
+		token := tokenizer.Token()
+		if lo.Contains(droppedTags, token.DataAtom) {
+			switch tt {
+			case xhtml.StartTagToken:
+				dropping++
+			case xhtml.EndTagToken:
+				dropping = max(0, dropping-1)
+			}
+			continue
+		}
+		if dropping > 0 {
+			continue
+		}
+
+		switch tt {
+		case xhtml.TextToken:
+			out.WriteString(html.EscapeString(token.Data))
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			allowed, ok := allowedTags[token.DataAtom]
+			if !ok {
+				continue
+			}
+			token.Attr = lo.Filter(token.Attr, func(attr xhtml.Attribute, _ int) bool {
+				if !lo.Contains(allowed, attr.Key) {
+					return false
+				}
+				return (attr.Key != "href" && attr.Key != "src") || safeUrl(attr.Val)
+			})
+			out.WriteString(token.String())
+		case xhtml.EndTagToken:
+			if _, ok := allowedTags[token.DataAtom]; ok {
+				out.WriteString(token.String())
+			}
+		}
+	}
+}
+
+var blankLines = regexp.MustCompile(`\n\s*\n+`)
+var spaces = regexp.MustCompile(`[ \t\r\f]+`)
+
+// HTMLToText renders the text of an HTML fragment, keeping line breaks
+// between block elements.
+func HTMLToText(s string) string {
+	var out strings.Builder
+	tokenizer := xhtml.NewTokenizer(strings.NewReader(s))
+	dropping := 0
+
+	for {
+		tt := tokenizer.Next()
+		if tt == xhtml.ErrorToken {
+			break
+		}
+
+		token := tokenizer.Token()
+		if lo.Contains(droppedTags, token.DataAtom) {
+			switch tt {
+			case xhtml.StartTagToken:
+				dropping++
+			case xhtml.EndTagToken:
+				dropping = max(0, dropping-1)
+			}
+			continue
+		}
+		if dropping > 0 {
+			continue
+		}
+
+		switch tt {
+		case xhtml.TextToken:
+			out.WriteString(spaces.ReplaceAllString(token.Data, " "))
+		case xhtml.StartTagToken, xhtml.EndTagToken, xhtml.SelfClosingTagToken:
+			if lo.Contains(blockTags, token.DataAtom) {
+				out.WriteString("\n")
+			}
+		}
+	}
+
+	lines := lo.Map(strings.Split(out.String(), "\n"), func(line string, _ int) string {
+		return strings.TrimSpace(line)
+	})
+
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n"))
+}
+
+// Excerpt cuts s to at most n runes, ending with an ellipsis when cut.
+func Excerpt(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	return strings.TrimSpace(string([]rune(s)[:n])) + "…"
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "`", "\\`")
+
+// HTMLToMarkdown renders an HTML fragment as Markdown, keeping headings,
+// emphasis, links, images, lists, quotes and code. Links and images other
+// than http(s) are dropped like SanitizeHTML does.
+func HTMLToMarkdown(s string) string {
+	var out strings.Builder
+	tokenizer := xhtml.NewTokenizer(strings.NewReader(s))
+	dropping, quote, pre := 0, 0, 0
+	var lists []atom.Atom
+	var counters []int
+	var links []string
+
+	newline := func(blank bool) {
+		if out.Len() == 0 {
+			return
+		}
+		prefix := strings.Repeat("> ", quote)
+		out.WriteString("\n" + prefix)
+		if blank {
+			out.WriteString("\n" + prefix)
+		}
+	}
+
+	for {
+		tt := tokenizer.Next()
+		if tt == xhtml.ErrorToken {
+			break
+		}
+
+		token := tokenizer.Token()
+		if lo.Contains(droppedTags, token.DataAtom) {
+			switch tt {
+			case xhtml.StartTagToken:
+				dropping++
+			case xhtml.EndTagToken:
+				dropping = max(0, dropping-1)
+			}
+			continue
+		}
+		if dropping > 0 {
+			continue
+		}
+
+		attr := func(key string) string {
+			a, _ := lo.Find(token.Attr, func(item xhtml.Attribute) bool {
+				return item.Key == key
+			})
+			return a.Val
+		}
+
+		start := tt == xhtml.StartTagToken || tt == xhtml.SelfClosingTagToken
+		switch {
+		case tt == xhtml.TextToken && pre > 0:
+			out.WriteString(token.Data)
+		case tt == xhtml.TextToken:
+			out.WriteString(markdownEscaper.Replace(spaces.ReplaceAllString(strings.ReplaceAll(token.Data, "\n", " "), " ")))
+		case token.DataAtom == atom.H1, token.DataAtom == atom.H2, token.DataAtom == atom.H3,
+			token.DataAtom == atom.H4, token.DataAtom == atom.H5, token.DataAtom == atom.H6:
+			newline(true)
+			if start {
+				out.WriteString(strings.Repeat("#", int(token.Data[1]-'0')) + " ")
+			}
+		case token.DataAtom == atom.P, token.DataAtom == atom.Div, token.DataAtom == atom.Figure, token.DataAtom == atom.Table:
+			newline(true)
+		case token.DataAtom == atom.Br, token.DataAtom == atom.Tr, token.DataAtom == atom.Figcaption:
+			newline(false)
+		case token.DataAtom == atom.Hr && start:
+			newline(true)
+			out.WriteString("---")
+			newline(true)
+		case token.DataAtom == atom.B, token.DataAtom == atom.Strong:
+			out.WriteString("**")
+		case token.DataAtom == atom.I, token.DataAtom == atom.Em:
+			out.WriteString("*")
+		case token.DataAtom == atom.S, token.DataAtom == atom.Del:
+			out.WriteString("~~")
+		case token.DataAtom == atom.Code && pre == 0:
+			out.WriteString("`")
+		case token.DataAtom == atom.Pre && tt == xhtml.StartTagToken:
+			newline(true)
+			out.WriteString("```")
+			newline(false)
+			pre++
+		case token.DataAtom == atom.Pre && tt == xhtml.EndTagToken:
+			pre = max(0, pre-1)
+			newline(false)
+			out.WriteString("```")
+			newline(true)
+		case token.DataAtom == atom.A && tt == xhtml.StartTagToken:
+			href := attr("href")
+			if !safeUrl(href) {
+				href = ""
+			}
+			links = append(links, href)
+			if href != "" {
+				out.WriteString("[")
+			}
+		case token.DataAtom == atom.A && tt == xhtml.EndTagToken && len(links) > 0:
+			if href := links[len(links)-1]; href != "" {
+				out.WriteString("](" + href + ")")
+			}
+			links = links[:len(links)-1]
+		case token.DataAtom == atom.Img && start:
+			if src := attr("src"); safeUrl(src) {
+				out.WriteString("![" + markdownEscaper.Replace(attr("alt")) + "](" + src + ")")
+			}
+		case (token.DataAtom == atom.Ul || token.DataAtom == atom.Ol) && tt == xhtml.StartTagToken:
+			// Nested lists continue on the next item's line.
+			if len(lists) == 0 {
+				newline(true)
+			}
+			lists = append(lists, token.DataAtom)
+			counters = append(counters, 0)
+		case (token.DataAtom == atom.Ul || token.DataAtom == atom.Ol) && tt == xhtml.EndTagToken && len(lists) > 0:
+			lists, counters = lists[:len(lists)-1], counters[:len(counters)-1]
+			if len(lists) == 0 {
+				newline(true)
+			}
+		case token.DataAtom == atom.Li && tt == xhtml.StartTagToken:
+			newline(false)
+			if depth := len(lists); depth > 0 {
+				out.WriteString(strings.Repeat("  ", depth-1))
+				if lists[depth-1] == atom.Ol {
+					counters[depth-1]++
+					out.WriteString(strconv.Itoa(counters[depth-1]) + ". ")
+				} else {
+					out.WriteString("- ")
+				}
+			} else {
+				out.WriteString("- ")
+			}
+		case token.DataAtom == atom.Blockquote && tt == xhtml.StartTagToken:
+			newline(true)
+			quote++
+			out.WriteString("> ")
+		case token.DataAtom == atom.Blockquote && tt == xhtml.EndTagToken:
+			quote = max(0, quote-1)
+			newline(true)
+		}
+	}
+
+	// Collapse the blank lines the blocks above leave behind.
+	blank := func(line string) bool {
+		return strings.Trim(line, "> ") == ""
+	}
+	lines := make([]string, 0)
+	for _, line := range strings.Split(out.String(), "\n") {
+		line = strings.TrimRight(line, " ")
+		if blank(line) && len(lines) == 0 {
+			continue
+		}
+		// Of consecutive blank lines keep the least quoted one, which ends
+		// the quote.
+		if last := len(lines) - 1; blank(line) && blank(lines[last]) {
+			if len(line) < len(lines[last]) {
+				lines[last] = line
+			}
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
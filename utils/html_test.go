@@ -0,0 +1,57 @@
+package utils
+
+import "testing"
+
+func TestSanitizeHTML(t *testing.T) {
+	cases := map[string]string{
+		`<p onclick="x()">hi <b>there</b></p>`:                         `<p>hi <b>there</b></p>`,
+		`<script>alert(1)</script><p>ok</p>`:                           `<p>ok</p>`,
+		`<a href="javascript:alert(1)">x</a>`:                          `<a>x</a>`,
+		`<a href="https://bbs.robomaster.com" target="_blank">x</a>`:   `<a href="https://bbs.robomaster.com">x</a>`,
+		`<img src="//img.example.com/a.png" onerror="x()">`:            `<img src="//img.example.com/a.png">`,
+		`<font color="red">1 &lt; 2</font>`:                            `1 &lt; 2`,
+		`<style>p{}</style><iframe src="https://x"><p>no</p></iframe>`: ``,
+	}
+
+	for in, want := range cases {
+		if got := SanitizeHTML(in); got != want {
+			t.Errorf("SanitizeHTML(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestHTMLToText(t *testing.T) {
+	in := "<h2>规则  更新</h2><p>第一段<br>第二行</p><p></p><ul><li>a</li><li>b &amp; c</li></ul><script>x</script>"
+	want := "规则 更新\n第一段\n第二行\na\nb & c"
+	if got := HTMLToText(in); got != want {
+		t.Errorf("HTMLToText() = %q, want %q", got, want)
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	if got := Excerpt("机甲大师超级对抗赛", 4); got != "机甲大师…" {
+		t.Errorf("Excerpt() = %q", got)
+	}
+	if got := Excerpt("RMUC", 4); got != "RMUC" {
+		t.Errorf("Excerpt() = %q", got)
+	}
+}
+
+func TestHTMLToMarkdown(t *testing.T) {
+	cases := map[string]string{
+		`<h2>规则更新</h2><p>第一段<br>第二行 <b>加粗</b> <em>斜体</em></p>`:                                   "## 规则更新\n\n第一段\n第二行 **加粗** *斜体*",
+		`<p><a href="https://bbs.robomaster.com">论坛</a> <a href="javascript:x()">坏链接</a></p>`:    "[论坛](https://bbs.robomaster.com) 坏链接",
+		`<img src="https://img.example.com/a.png" alt="装甲板"><img src="data:image/png;base64,x">`: "![装甲板](https://img.example.com/a.png)",
+		`<ul><li>a</li><li>b<ol><li>c</li><li>d</li></ol></li></ul><p>end</p>`:                   "- a\n- b\n  1. c\n  2. d\n\nend",
+		`<blockquote><p>引用</p><p>第二段</p></blockquote><p>正文</p>`:                                  "> 引用\n>\n> 第二段\n\n正文",
+		`<pre><code>int *p = 0;
+return;</code></pre><p>用 <code>a_b</code> 与 1*2</p>`: "```\nint *p = 0;\nreturn;\n```\n\n用 `a\\_b` 与 1\\*2",
+		`<script>x</script><hr><p>ok</p>`: "---\n\nok",
+	}
+
+	for in, want := range cases {
+		if got := HTMLToMarkdown(in); got != want {
+			t.Errorf("HTMLToMarkdown(%q) = %q, want %q", in, got, want)
+		}
+	}
+}